@@ -18,5 +18,5 @@ require (
 	github.com/terraform-providers/terraform-provider-random v0.0.0-20180820145304-a3da7a23d7f9
 	golang.org/x/build v0.0.0-20190111050920-041ab4dc3f9d // indirect
 	golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45
-	google.golang.org/api v0.5.1-0.20190606153627-ba71ec00d36a
+	google.golang.org/api v0.18.0
 )
@@ -3,6 +3,7 @@ package google
 import (
 	"bytes"
 	"fmt"
+	"net/url"
 
 	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
@@ -12,6 +13,12 @@ type ComputeOperationWaiter struct {
 	Service *compute.Service
 	Op      *compute.Operation
 	Project string
+	// Parent is set instead of Project for operations scoped to an
+	// organization or folder, e.g. "organizations/123".
+	Parent string
+	// Config is used to poll organization operations, which the compute
+	// client doesn't support.
+	Config *Config
 }
 
 func (w *ComputeOperationWaiter) State() string {
@@ -48,6 +55,17 @@ func (w *ComputeOperationWaiter) QueryOp() (interface{}, error) {
 	} else if w.Op.Region != "" {
 		region := GetResourceNameFromSelfLink(w.Op.Region)
 		return w.Service.RegionOperations.Get(w.Project, region, w.Op.Name).Do()
+	} else if w.Parent != "" {
+		u := fmt.Sprintf("https://www.googleapis.com/compute/beta/locations/global/operations/%s?parentId=%s", w.Op.Name, url.QueryEscape(w.Parent))
+		res, err := sendRequest(w.Config, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		op := &compute.Operation{}
+		if err := Convert(res, op); err != nil {
+			return nil, err
+		}
+		return op, nil
 	}
 	return w.Service.GlobalOperations.Get(w.Project, w.Op.Name).Do()
 }
@@ -85,6 +103,20 @@ func computeOperationWaitTime(client *compute.Service, op *compute.Operation, pr
 	return OperationWait(w, activity, timeoutMinutes)
 }
 
+func computeOrgOperationWaitTime(config *Config, op *compute.Operation, parent, activity string, timeoutMinutes int) error {
+	w := &ComputeOperationWaiter{
+		Service: config.clientCompute,
+		Op:      op,
+		Parent:  parent,
+		Config:  config,
+	}
+
+	if err := w.SetOp(op); err != nil {
+		return err
+	}
+	return OperationWait(w, activity, timeoutMinutes)
+}
+
 func computeBetaOperationWaitTime(client *compute.Service, op *computeBeta.Operation, project, activity string, timeoutMin int) error {
 	opV1 := &compute.Operation{}
 	err := Convert(op, opV1)
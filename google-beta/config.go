@@ -38,6 +38,7 @@ import (
 	iap "google.golang.org/api/iap/v1beta1"
 	cloudlogging "google.golang.org/api/logging/v2"
 	"google.golang.org/api/option"
+	oslogin "google.golang.org/api/oslogin/v1"
 	"google.golang.org/api/pubsub/v1"
 	runtimeconfig "google.golang.org/api/runtimeconfig/v1beta1"
 	"google.golang.org/api/servicemanagement/v1"
@@ -53,12 +54,14 @@ import (
 // Config is the configuration structure used to instantiate the Google
 // provider.
 type Config struct {
-	Credentials string
-	AccessToken string
-	Project     string
-	Region      string
-	Zone        string
-	Scopes      []string
+	Credentials        string
+	AccessToken        string
+	AccessTokenCommand string
+	AccessTokenFile    string
+	Project            string
+	Region             string
+	Zone               string
+	Scopes             []string
 
 	client    *http.Client
 	userAgent string
@@ -80,6 +83,7 @@ type Config struct {
 	clientIamCredentials         *iamcredentials.Service
 	clientKms                    *cloudkms.Service
 	clientLogging                *cloudlogging.Service
+	clientOSLogin                *oslogin.Service
 	clientPubsub                 *pubsub.Service
 	clientResourceManager        *cloudresourcemanager.Service
 	clientResourceManagerV2Beta1 *resourceManagerV2Beta1.Service
@@ -317,6 +321,13 @@ func (c *Config) LoadAndValidate() error {
 	}
 	c.clientSourceRepo.UserAgent = userAgent
 
+	log.Printf("[INFO] Instantiating Google Cloud OS Login Client...")
+	c.clientOSLogin, err = oslogin.NewService(context, option.WithHTTPClient(client))
+	if err != nil {
+		return err
+	}
+	c.clientOSLogin.UserAgent = userAgent
+
 	log.Printf("[INFO] Instantiating Google Cloud Spanner Client...")
 	c.clientSpanner, err = spanner.NewService(context, option.WithHTTPClient(client))
 	if err != nil {
@@ -388,12 +399,47 @@ func (c *Config) getTokenSource(clientScopes []string) (oauth2.TokenSource, erro
 		return oauth2.StaticTokenSource(token), nil
 	}
 
+	if c.AccessTokenCommand != "" {
+		log.Printf("[INFO] Authenticating using configured 'access_token_command'...")
+		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+		ts := &commandTokenSource{command: c.AccessTokenCommand}
+		token, err := ts.Token()
+		if err != nil {
+			return nil, err
+		}
+		return oauth2.ReuseTokenSource(token, ts), nil
+	}
+
+	if c.AccessTokenFile != "" {
+		log.Printf("[INFO] Authenticating using configured 'access_token_file'...")
+		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+		ts := &fileTokenSource{path: c.AccessTokenFile}
+		token, err := ts.Token()
+		if err != nil {
+			return nil, err
+		}
+		return oauth2.ReuseTokenSource(token, ts), nil
+	}
+
 	if c.Credentials != "" {
 		contents, _, err := pathorcontents.Read(c.Credentials)
 		if err != nil {
 			return nil, fmt.Errorf("Error loading credentials: %s", err)
 		}
 
+		externalAccount, err := parseExternalAccountCredentials([]byte(contents))
+		if err != nil {
+			return nil, err
+		}
+		if externalAccount != nil {
+			log.Printf("[INFO] Authenticating using configured external_account 'credentials'...")
+			log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+			// Token requests are themselves unauthenticated, so don't reuse the
+			// provider's oauth2 client here.
+			client := &http.Client{Timeout: 30 * time.Second}
+			return externalAccount.TokenSource(client, clientScopes), nil
+		}
+
 		creds, err := googleoauth.CredentialsFromJSON(context.Background(), []byte(contents), clientScopes...)
 		if err != nil {
 			return nil, fmt.Errorf("Unable to parse credentials from '%s': %s", contents, err)
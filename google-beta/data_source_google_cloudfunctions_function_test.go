@@ -42,6 +42,7 @@ func testAccDataSourceGoogleCloudFunctionsFunctionConfig(functionName, bucketNam
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
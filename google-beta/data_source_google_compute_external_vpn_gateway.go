@@ -0,0 +1,27 @@
+package google
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleComputeExternalVpnGateway() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceComputeExternalVpnGateway().Schema)
+
+	// Set 'Required' schema elements
+	addRequiredFieldsToSchema(dsSchema, "name")
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "project")
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeExternalVpnGatewayRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleComputeExternalVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("name").(string))
+
+	return resourceComputeExternalVpnGatewayRead(d, meta)
+}
@@ -0,0 +1,51 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceComputeExternalVpnGateway(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-external-gateway-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeExternalVpnGatewayConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					checkDataSourceStateMatchesResourceState("data.google_compute_external_vpn_gateway.foobar", "google_compute_external_vpn_gateway.foobar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeExternalVpnGatewayConfig(name string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "foobar" {
+	name            = "%s"
+	redundancy_type = "SINGLE_IP_INTERNALLY_REDUNDANT"
+
+	interface {
+		id         = 0
+		ip_address = "8.8.8.8"
+	}
+
+	labels = {
+		foo = "bar"
+	}
+}
+
+data "google_compute_external_vpn_gateway" "foobar" {
+	name = "${google_compute_external_vpn_gateway.foobar.name}"
+}
+`, name)
+}
@@ -0,0 +1,118 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func dataSourceGoogleComputeInstanceGuestAttributes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeInstanceGuestAttributesRead,
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"query_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"variable_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"query_value": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"variable_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInstanceGuestAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance := GetResourceNameFromSelfLink(d.Get("instance").(string))
+
+	call := config.clientComputeBeta.Instances.GetGuestAttributes(project, zone, instance)
+	if v, ok := d.GetOk("query_path"); ok {
+		call = call.QueryPath(v.(string))
+	}
+	if v, ok := d.GetOk("variable_key"); ok {
+		call = call.VariableKey(v.(string))
+	}
+
+	attrs, err := call.Do()
+	if err != nil {
+		return fmt.Errorf("Error reading guest attributes of instance %q: %s", instance, err)
+	}
+
+	if err := d.Set("query_value", flattenGuestAttributesQueryValue(attrs.QueryValue)); err != nil {
+		return fmt.Errorf("Error setting query_value: %s", err)
+	}
+	d.Set("variable_value", attrs.VariableValue)
+	d.Set("project", project)
+	d.Set("zone", zone)
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s", project, zone, instance, attrs.QueryPath, attrs.VariableKey))
+
+	return nil
+}
+
+func flattenGuestAttributesQueryValue(v *computeBeta.GuestAttributesValue) []map[string]interface{} {
+	if v == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(v.Items))
+	for _, entry := range v.Items {
+		result = append(result, map[string]interface{}{
+			"key":       entry.Key,
+			"namespace": entry.Namespace,
+			"value":     entry.Value,
+		})
+	}
+	return result
+}
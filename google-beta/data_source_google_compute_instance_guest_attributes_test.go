@@ -0,0 +1,59 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceComputeInstanceGuestAttributes_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-guest-attrs-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeInstanceGuestAttributes_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_instance_guest_attributes.attrs", "query_path", "hostkeys/"),
+					resource.TestCheckResourceAttrSet("data.google_compute_instance_guest_attributes.attrs", "query_value.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeInstanceGuestAttributes_basic(instanceName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance" "default" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "debian-cloud/debian-9"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	metadata = {
+		enable-guest-attributes = "TRUE"
+	}
+}
+
+data "google_compute_instance_guest_attributes" "attrs" {
+	instance   = "${google_compute_instance.default.name}"
+	zone       = "${google_compute_instance.default.zone}"
+	query_path = "hostkeys/"
+}
+`, instanceName)
+}
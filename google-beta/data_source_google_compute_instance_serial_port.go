@@ -0,0 +1,69 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceGoogleComputeInstanceSerialPort() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeInstanceSerialPortRead,
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4),
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"contents": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInstanceSerialPortRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance := GetResourceNameFromSelfLink(d.Get("instance").(string))
+	port := int64(d.Get("port").(int))
+
+	output, err := config.clientCompute.Instances.GetSerialPortOutput(project, zone, instance).Port(port).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading serial port %d output of instance %q: %s", port, instance, err)
+	}
+
+	d.Set("contents", output.Contents)
+	d.Set("project", project)
+	d.Set("zone", zone)
+	d.SetId(output.SelfLink)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceComputeInstanceSerialPort_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-serial-data-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeInstanceSerialPort_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_instance_serial_port.serial", "port", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_instance_serial_port.serial", "zone", "us-central1-a"),
+					resource.TestMatchResourceAttr("data.google_compute_instance_serial_port.serial", "contents", regexp.MustCompile(".+")),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeInstanceSerialPort_basic(instanceName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance" "default" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "debian-cloud/debian-9"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	metadata = {
+		serial-port-logging-enable = "TRUE"
+	}
+}
+
+data "google_compute_instance_serial_port" "serial" {
+	instance = "${google_compute_instance.default.name}"
+	zone     = "${google_compute_instance.default.zone}"
+	port     = 1
+}
+`, instanceName)
+}
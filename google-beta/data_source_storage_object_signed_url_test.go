@@ -227,6 +227,7 @@ func testAccTestGoogleStorageObjectSignedURL(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "story" {
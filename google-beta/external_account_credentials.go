@@ -0,0 +1,285 @@
+package google
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	externalAccountCredentialsType = "external_account"
+	stsTokenExchangeGrantType      = "urn:ietf:params:oauth:grant-type:token-exchange"
+	stsRequestedTokenType          = "urn:ietf:params:oauth:token-type:access_token"
+	defaultStsTokenUrl             = "https://sts.googleapis.com/v1/token"
+)
+
+// externalAccountCredentials is the `external_account` credential
+// configuration used by workload identity federation. A subject token issued
+// by a third-party identity provider (an OIDC ID token or SAML assertion) is
+// exchanged through the Security Token Service for a Google access token, which
+// can optionally be used to impersonate a service account.
+type externalAccountCredentials struct {
+	Type                           string                          `json:"type"`
+	Audience                       string                          `json:"audience"`
+	SubjectTokenType               string                          `json:"subject_token_type"`
+	TokenUrl                       string                          `json:"token_url"`
+	ServiceAccountImpersonationUrl string                          `json:"service_account_impersonation_url"`
+	CredentialSource               externalAccountCredentialSource `json:"credential_source"`
+}
+
+type externalAccountCredentialSource struct {
+	File    string                          `json:"file"`
+	Url     string                          `json:"url"`
+	Headers map[string]string               `json:"headers"`
+	Format  externalAccountCredentialFormat `json:"format"`
+}
+
+type externalAccountCredentialFormat struct {
+	// Type is either "text" (the default) or "json".
+	Type                  string `json:"type"`
+	SubjectTokenFieldName string `json:"subject_token_field_name"`
+}
+
+// parseExternalAccountCredentials returns the parsed configuration if contents
+// is an `external_account` credential configuration, or nil if it is some other
+// kind of credentials.
+func parseExternalAccountCredentials(contents []byte) (*externalAccountCredentials, error) {
+	var creds externalAccountCredentials
+	if err := json.Unmarshal(contents, &creds); err != nil {
+		// Not our concern; let the caller report invalid JSON.
+		return nil, nil
+	}
+	if creds.Type != externalAccountCredentialsType {
+		return nil, nil
+	}
+
+	if creds.Audience == "" {
+		return nil, fmt.Errorf("external_account credentials are missing an audience")
+	}
+	if creds.SubjectTokenType == "" {
+		return nil, fmt.Errorf("external_account credentials are missing a subject_token_type")
+	}
+	if creds.TokenUrl == "" {
+		creds.TokenUrl = defaultStsTokenUrl
+	}
+
+	source := creds.CredentialSource
+	if (source.File == "") == (source.Url == "") {
+		return nil, fmt.Errorf("external_account credentials must have exactly one of credential_source.file or credential_source.url")
+	}
+	switch source.Format.Type {
+	case "", "text":
+	case "json":
+		if source.Format.SubjectTokenFieldName == "" {
+			return nil, fmt.Errorf("external_account credentials with a json credential_source.format must set subject_token_field_name")
+		}
+	default:
+		return nil, fmt.Errorf("external_account credentials have an unsupported credential_source.format type %q", source.Format.Type)
+	}
+
+	return &creds, nil
+}
+
+// TokenSource returns a token source that performs the token exchange, and
+// repeats it each time the resulting access token expires.
+func (c *externalAccountCredentials) TokenSource(client *http.Client, scopes []string) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &externalAccountTokenSource{
+		creds:  c,
+		client: client,
+		scopes: scopes,
+	})
+}
+
+type externalAccountTokenSource struct {
+	creds  *externalAccountCredentials
+	client *http.Client
+	scopes []string
+}
+
+func (ts *externalAccountTokenSource) Token() (*oauth2.Token, error) {
+	subjectToken, err := ts.subjectToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := ts.exchangeToken(subjectToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if ts.creds.ServiceAccountImpersonationUrl == "" {
+		return token, nil
+	}
+
+	return ts.impersonate(token)
+}
+
+// subjectToken reads the third-party token from the configured file or URL.
+func (ts *externalAccountTokenSource) subjectToken() (string, error) {
+	source := ts.creds.CredentialSource
+
+	var raw []byte
+	var err error
+	if source.File != "" {
+		log.Printf("[DEBUG] Reading external account subject token from %q", source.File)
+		raw, err = ioutil.ReadFile(source.File)
+		if err != nil {
+			return "", fmt.Errorf("Error reading external account subject token: %s", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Retrieving external account subject token from %q", source.Url)
+		req, err := http.NewRequest("GET", source.Url, nil)
+		if err != nil {
+			return "", err
+		}
+		for k, v := range source.Headers {
+			req.Header.Set(k, v)
+		}
+		raw, err = ts.do(req)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving external account subject token: %s", err)
+		}
+	}
+
+	if source.Format.Type != "json" {
+		token := strings.TrimSpace(string(raw))
+		if token == "" {
+			return "", fmt.Errorf("External account subject token is empty")
+		}
+		return token, nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", fmt.Errorf("Error parsing external account subject token: %s", err)
+	}
+	token, ok := fields[source.Format.SubjectTokenFieldName].(string)
+	if !ok || token == "" {
+		return "", fmt.Errorf("External account subject token response has no %q field", source.Format.SubjectTokenFieldName)
+	}
+	return token, nil
+}
+
+// exchangeToken trades the subject token for a Google access token using the
+// OAuth 2.0 token exchange (RFC 8693) implemented by the Security Token Service.
+func (ts *externalAccountTokenSource) exchangeToken(subjectToken string) (*oauth2.Token, error) {
+	// When impersonating, the federated token only needs to be able to call
+	// the IAM Credentials API; the requested scopes apply to the final token.
+	scopes := ts.scopes
+	if ts.creds.ServiceAccountImpersonationUrl != "" {
+		scopes = []string{"https://www.googleapis.com/auth/cloud-platform"}
+	}
+
+	form := url.Values{
+		"grant_type":           {stsTokenExchangeGrantType},
+		"audience":             {ts.creds.Audience},
+		"scope":                {strings.Join(scopes, " ")},
+		"requested_token_type": {stsRequestedTokenType},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {ts.creds.SubjectTokenType},
+	}
+
+	req, err := http.NewRequest("POST", ts.creds.TokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	log.Printf("[DEBUG] Exchanging external account subject token at %q", ts.creds.TokenUrl)
+	body, err := ts.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error exchanging external account subject token: %s", err)
+	}
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("Error parsing token exchange response: %s", err)
+	}
+	if resp.AccessToken == "" {
+		return nil, fmt.Errorf("Token exchange response did not contain an access_token")
+	}
+
+	token := &oauth2.Token{
+		AccessToken: resp.AccessToken,
+		TokenType:   resp.TokenType,
+	}
+	if resp.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// impersonate uses the federated token to generate an access token for the
+// configured service account through the IAM Credentials API.
+func (ts *externalAccountTokenSource) impersonate(federated *oauth2.Token) (*oauth2.Token, error) {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"scope": ts.scopes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", ts.creds.ServiceAccountImpersonationUrl, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	federated.SetAuthHeader(req)
+
+	log.Printf("[DEBUG] Impersonating service account with %q", ts.creds.ServiceAccountImpersonationUrl)
+	body, err := ts.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error impersonating service account: %s", err)
+	}
+
+	var resp struct {
+		AccessToken string `json:"accessToken"`
+		ExpireTime  string `json:"expireTime"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("Error parsing service account impersonation response: %s", err)
+	}
+	if resp.AccessToken == "" {
+		return nil, fmt.Errorf("Service account impersonation response did not contain an accessToken")
+	}
+
+	expiry, err := time.Parse(time.RFC3339, resp.ExpireTime)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing service account impersonation expireTime %q: %s", resp.ExpireTime, err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: resp.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      expiry,
+	}, nil
+}
+
+func (ts *externalAccountTokenSource) do(req *http.Request) ([]byte, error) {
+	res, err := ts.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, fmt.Errorf("%s %s returned status %d: %s", req.Method, req.URL, res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
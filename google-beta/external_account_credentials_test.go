@@ -0,0 +1,241 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+// stubSts serves a fake Security Token Service token exchange endpoint, a
+// fake OIDC subject token endpoint and a fake IAM Credentials endpoint.
+func stubSts(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/subject", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "bearer runner-token" {
+			http.Error(w, "missing runner token", http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"count": 1, "value": "oidc-from-url"}`)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		expected := map[string]string{
+			"grant_type":           stsTokenExchangeGrantType,
+			"audience":             "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider",
+			"requested_token_type": stsRequestedTokenType,
+			"subject_token_type":   "urn:ietf:params:oauth:token-type:jwt",
+		}
+		for k, v := range expected {
+			if got := r.PostForm.Get(k); got != v {
+				http.Error(w, fmt.Sprintf("unexpected %s %q", k, got), http.StatusBadRequest)
+				return
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":      "federated-" + r.PostForm.Get("subject_token"),
+			"issued_token_type": stsRequestedTokenType,
+			"token_type":        "Bearer",
+			"expires_in":        3600,
+		})
+	})
+	mux.HandleFunc("/impersonate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer federated-oidc-from-file" {
+			http.Error(w, "unexpected federated token "+r.Header.Get("Authorization"), http.StatusUnauthorized)
+			return
+		}
+		var body struct {
+			Scope []string `json:"scope"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Scope) != 1 || body.Scope[0] != testOauthScope {
+			http.Error(w, "unexpected scopes", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"accessToken": "impersonated",
+			"expireTime":  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		})
+	})
+	mux.HandleFunc("/denied", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "invalid_grant"}`, http.StatusBadRequest)
+	})
+	return httptest.NewServer(mux)
+}
+
+func writeSubjectTokenFile(t *testing.T, token string) string {
+	f, err := ioutil.TempFile("", "tf-google-subject-token")
+	if err != nil {
+		t.Fatalf("error creating temp file: %s", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(token); err != nil {
+		t.Fatalf("error writing subject token: %s", err)
+	}
+	return f.Name()
+}
+
+func externalAccountJSON(tokenUrl, impersonationUrl string, source map[string]interface{}) string {
+	creds := map[string]interface{}{
+		"type":               "external_account",
+		"audience":           "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider",
+		"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+		"token_url":          tokenUrl,
+		"credential_source":  source,
+	}
+	if impersonationUrl != "" {
+		creds["service_account_impersonation_url"] = impersonationUrl
+	}
+	b, _ := json.Marshal(creds)
+	return string(b)
+}
+
+func TestExternalAccountCredentials_fileSourceWithImpersonation(t *testing.T) {
+	server := stubSts(t)
+	defer server.Close()
+
+	path := writeSubjectTokenFile(t, "oidc-from-file\n")
+	defer os.Remove(path)
+
+	config := Config{
+		Credentials: externalAccountJSON(server.URL+"/token", server.URL+"/impersonate", map[string]interface{}{
+			"file": path,
+		}),
+		Scopes: []string{testOauthScope},
+	}
+
+	ts, err := config.getTokenSource(config.Scopes)
+	if err != nil {
+		t.Fatalf("error getting token source: %s", err)
+	}
+
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("error getting token: %s", err)
+	}
+	if token.AccessToken != "impersonated" {
+		t.Fatalf("expected impersonated token, got %q", token.AccessToken)
+	}
+}
+
+func TestExternalAccountCredentials_urlSourceWithoutImpersonation(t *testing.T) {
+	server := stubSts(t)
+	defer server.Close()
+
+	creds, err := parseExternalAccountCredentials([]byte(externalAccountJSON(server.URL+"/token", "", map[string]interface{}{
+		"url": server.URL + "/subject",
+		"headers": map[string]string{
+			"Authorization": "bearer runner-token",
+		},
+		"format": map[string]string{
+			"type":                     "json",
+			"subject_token_field_name": "value",
+		},
+	})))
+	if err != nil {
+		t.Fatalf("error parsing credentials: %s", err)
+	}
+
+	token, err := creds.TokenSource(server.Client(), []string{testOauthScope}).Token()
+	if err != nil {
+		t.Fatalf("error getting token: %s", err)
+	}
+	if token.AccessToken != "federated-oidc-from-url" {
+		t.Fatalf("expected federated token, got %q", token.AccessToken)
+	}
+	if token.Expiry.Before(time.Now().Add(50 * time.Minute)) {
+		t.Fatalf("expected token to expire in an hour, got %s", token.Expiry)
+	}
+}
+
+func TestExternalAccountCredentials_exchangeError(t *testing.T) {
+	server := stubSts(t)
+	defer server.Close()
+
+	path := writeSubjectTokenFile(t, "oidc-from-file")
+	defer os.Remove(path)
+
+	creds, err := parseExternalAccountCredentials([]byte(externalAccountJSON(server.URL+"/denied", "", map[string]interface{}{
+		"file": path,
+	})))
+	if err != nil {
+		t.Fatalf("error parsing credentials: %s", err)
+	}
+
+	if _, err := creds.TokenSource(server.Client(), []string{testOauthScope}).Token(); err == nil {
+		t.Fatalf("expected error from a failed token exchange")
+	}
+}
+
+func TestParseExternalAccountCredentials(t *testing.T) {
+	cases := map[string]struct {
+		Json         string
+		ExternalAcct bool
+		ExpectError  bool
+	}{
+		"service account": {
+			Json: `{"type": "service_account", "project_id": "foo"}`,
+		},
+		"not json": {
+			Json: `{this is not json}`,
+		},
+		"file source": {
+			Json:         externalAccountJSON("", "", map[string]interface{}{"file": "/var/run/token"}),
+			ExternalAcct: true,
+		},
+		"no source": {
+			Json:        externalAccountJSON("", "", map[string]interface{}{}),
+			ExpectError: true,
+		},
+		"both sources": {
+			Json:        externalAccountJSON("", "", map[string]interface{}{"file": "/var/run/token", "url": "http://localhost/token"}),
+			ExpectError: true,
+		},
+		"json format without field name": {
+			Json:        externalAccountJSON("", "", map[string]interface{}{"file": "/var/run/token", "format": map[string]string{"type": "json"}}),
+			ExpectError: true,
+		},
+		"missing audience": {
+			Json:        `{"type": "external_account", "subject_token_type": "urn:ietf:params:oauth:token-type:jwt", "credential_source": {"file": "/var/run/token"}}`,
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		creds, err := parseExternalAccountCredentials([]byte(tc.Json))
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if (creds != nil) != tc.ExternalAcct {
+			t.Errorf("%s: expected external account %t, got %#v", tn, tc.ExternalAcct, creds)
+		}
+		if creds != nil && creds.TokenUrl != defaultStsTokenUrl {
+			t.Errorf("%s: expected default token url, got %q", tn, creds.TokenUrl)
+		}
+	}
+}
+
+func TestValidateCredentials_externalAccount(t *testing.T) {
+	creds := externalAccountJSON("", "", map[string]interface{}{"file": "/var/run/token"})
+	if _, errs := validateCredentials(creds, "credentials"); len(errs) > 0 {
+		t.Fatalf("expected external account credentials to be valid, got %v", errs)
+	}
+
+	invalid := externalAccountJSON("", "", map[string]interface{}{})
+	if _, errs := validateCredentials(invalid, "credentials"); len(errs) == 0 {
+		t.Fatalf("expected external account credentials without a source to be invalid")
+	}
+}
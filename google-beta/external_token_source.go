@@ -0,0 +1,112 @@
+package google
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Tokens returned without expiry information are considered valid for this
+// long before the command is re-executed or the file is re-read.
+const defaultExternalTokenLifetime = 5 * time.Minute
+
+// externalTokenResponse is the optional JSON format an external token source
+// may produce, so that helpers can tell Terraform when their token expires.
+type externalTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Expiry      string `json:"expiry"`
+}
+
+// commandTokenSource obtains access tokens by executing a credential helper
+// command. It is meant to be wrapped in an oauth2.ReuseTokenSource so the
+// command is only executed again once the previous token has expired.
+type commandTokenSource struct {
+	command string
+}
+
+func (ts *commandTokenSource) Token() (*oauth2.Token, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", ts.command)
+	} else {
+		cmd = exec.Command("sh", "-c", ts.command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Printf("[DEBUG] Executing access token command %q", ts.command)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("Error executing access token command %q: %s: %s", ts.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseExternalToken(out, time.Now())
+}
+
+// fileTokenSource obtains access tokens by reading a file that is kept up to
+// date by another process. It is meant to be wrapped in an
+// oauth2.ReuseTokenSource so the file is only read again once the previous
+// token has expired.
+type fileTokenSource struct {
+	path string
+}
+
+func (ts *fileTokenSource) Token() (*oauth2.Token, error) {
+	log.Printf("[DEBUG] Reading access token file %q", ts.path)
+	contents, err := ioutil.ReadFile(ts.path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading access token file %q: %s", ts.path, err)
+	}
+
+	return parseExternalToken(contents, time.Now())
+}
+
+// parseExternalToken accepts either a bare access token or a JSON object with
+// an `access_token` and either `expires_in` (seconds) or `expiry` (RFC 3339).
+func parseExternalToken(raw []byte, now time.Time) (*oauth2.Token, error) {
+	contents := strings.TrimSpace(string(raw))
+	if contents == "" {
+		return nil, fmt.Errorf("External access token source returned an empty token")
+	}
+
+	if !strings.HasPrefix(contents, "{") {
+		return &oauth2.Token{
+			AccessToken: contents,
+			Expiry:      now.Add(defaultExternalTokenLifetime),
+		}, nil
+	}
+
+	var resp externalTokenResponse
+	if err := json.Unmarshal([]byte(contents), &resp); err != nil {
+		return nil, fmt.Errorf("Error parsing external access token: %s", err)
+	}
+	if resp.AccessToken == "" {
+		return nil, fmt.Errorf("External access token source returned JSON without an access_token")
+	}
+
+	token := &oauth2.Token{AccessToken: resp.AccessToken}
+	switch {
+	case resp.Expiry != "":
+		expiry, err := time.Parse(time.RFC3339, resp.Expiry)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing external access token expiry %q: %s", resp.Expiry, err)
+		}
+		token.Expiry = expiry
+	case resp.ExpiresIn > 0:
+		token.Expiry = now.Add(time.Duration(resp.ExpiresIn) * time.Second)
+	default:
+		token.Expiry = now.Add(defaultExternalTokenLifetime)
+	}
+
+	return token, nil
+}
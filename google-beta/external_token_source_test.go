@@ -0,0 +1,166 @@
+package google
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestParseExternalToken(t *testing.T) {
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		Raw         string
+		Token       string
+		Expiry      time.Time
+		ExpectError bool
+	}{
+		"bare token": {
+			Raw:    "ya29.token\n",
+			Token:  "ya29.token",
+			Expiry: now.Add(defaultExternalTokenLifetime),
+		},
+		"json with expires_in": {
+			Raw:    `{"access_token": "ya29.token", "expires_in": 3600}`,
+			Token:  "ya29.token",
+			Expiry: now.Add(time.Hour),
+		},
+		"json with expiry": {
+			Raw:    `{"access_token": "ya29.token", "expiry": "2019-06-01T12:30:00Z"}`,
+			Token:  "ya29.token",
+			Expiry: now.Add(30 * time.Minute),
+		},
+		"json without expiry": {
+			Raw:    `{"access_token": "ya29.token"}`,
+			Token:  "ya29.token",
+			Expiry: now.Add(defaultExternalTokenLifetime),
+		},
+		"json without token": {
+			Raw:         `{"expires_in": 3600}`,
+			ExpectError: true,
+		},
+		"invalid json": {
+			Raw:         `{"access_token": `,
+			ExpectError: true,
+		},
+		"invalid expiry": {
+			Raw:         `{"access_token": "ya29.token", "expiry": "tomorrow"}`,
+			ExpectError: true,
+		},
+		"empty": {
+			Raw:         "  \n",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		token, err := parseExternalToken([]byte(tc.Raw), now)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected error, got token %#v", tn, token)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if token.AccessToken != tc.Token {
+			t.Errorf("%s: expected access token %q, got %q", tn, tc.Token, token.AccessToken)
+		}
+		if !token.Expiry.Equal(tc.Expiry) {
+			t.Errorf("%s: expected expiry %s, got %s", tn, tc.Expiry, token.Expiry)
+		}
+	}
+}
+
+func TestFileTokenSource_rereadsOnExpiry(t *testing.T) {
+	f, err := ioutil.TempFile("", "tf-google-token")
+	if err != nil {
+		t.Fatalf("error creating temp file: %s", err)
+	}
+	defer os.Remove(f.Name())
+	f.Close()
+
+	if err := ioutil.WriteFile(f.Name(), []byte(`{"access_token": "first", "expires_in": 1}`), 0600); err != nil {
+		t.Fatalf("error writing token file: %s", err)
+	}
+
+	ts := &fileTokenSource{path: f.Name()}
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("error reading token: %s", err)
+	}
+	if token.AccessToken != "first" {
+		t.Fatalf("expected token %q, got %q", "first", token.AccessToken)
+	}
+
+	if err := ioutil.WriteFile(f.Name(), []byte("second"), 0600); err != nil {
+		t.Fatalf("error writing token file: %s", err)
+	}
+
+	// The first token expires within oauth2's expiry delta, so the reuse
+	// token source must read the file again.
+	token, err = oauth2.ReuseTokenSource(token, ts).Token()
+	if err != nil {
+		t.Fatalf("error reading token: %s", err)
+	}
+	if token.AccessToken != "second" {
+		t.Fatalf("expected token %q, got %q", "second", token.AccessToken)
+	}
+}
+
+func TestFileTokenSource_missingFile(t *testing.T) {
+	ts := &fileTokenSource{path: "./test-fixtures/does-not-exist"}
+	if _, err := ts.Token(); err == nil {
+		t.Fatalf("expected error reading missing token file")
+	}
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("command token source test relies on a POSIX shell")
+	}
+
+	ts := &commandTokenSource{command: `echo '{"access_token": "from-command", "expires_in": 3600}'`}
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("error executing command: %s", err)
+	}
+	if token.AccessToken != "from-command" {
+		t.Fatalf("expected token %q, got %q", "from-command", token.AccessToken)
+	}
+
+	ts = &commandTokenSource{command: "echo broken >&2; exit 1"}
+	if _, err := ts.Token(); err == nil {
+		t.Fatalf("expected error from failing command")
+	}
+}
+
+func TestConfigLoadAndValidate_accessTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("command token source test relies on a POSIX shell")
+	}
+
+	config := Config{
+		AccessTokenCommand: "echo ya29.token",
+		Project:            "my-gce-project",
+		Region:             "us-central1",
+	}
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	token, err := config.tokenSource.Token()
+	if err != nil {
+		t.Fatalf("error getting token: %s", err)
+	}
+	if token.AccessToken != "ya29.token" {
+		t.Fatalf("expected token %q, got %q", "ya29.token", token.AccessToken)
+	}
+}
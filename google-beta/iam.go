@@ -50,7 +50,9 @@ type resourceIdParserFunc func(d *schema.ResourceData, config *Config) error
 // for just reading policies from IAM
 func iamPolicyReadWithRetry(updater ResourceIamUpdater) (*cloudresourcemanager.Policy, error) {
 	mutexKey := updater.GetMutexKey()
-	mutexKV.Lock(mutexKey)
+	if err := mutexKV.Lock(mutexKey); err != nil {
+		return nil, err
+	}
 	defer mutexKV.Unlock(mutexKey)
 
 	log.Printf("[DEBUG] Retrieving policy for %s\n", updater.DescribeResource())
@@ -68,7 +70,9 @@ func iamPolicyReadWithRetry(updater ResourceIamUpdater) (*cloudresourcemanager.P
 
 func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
 	mutexKey := updater.GetMutexKey()
-	mutexKV.Lock(mutexKey)
+	if err := mutexKV.Lock(mutexKey); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(mutexKey)
 
 	backoff := time.Second
@@ -0,0 +1,197 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// serialize changes across arbitrary collaborators that share knowledge of the
+// keys they must serialize on.
+//
+// Unlike helper/mutexkv, it records which function holds each key so that
+// waiting callers can log who they are blocked on, optionally gives up after a
+// timeout, and keeps per-key contention statistics for the run.
+type MutexKV struct {
+	lock    sync.Mutex
+	store   map[string]*keyLock
+	timeout time.Duration
+}
+
+// keyLock is a mutex for a single key. A buffered channel is used instead of a
+// sync.Mutex so that acquisition can be abandoned when a timeout is set.
+type keyLock struct {
+	sem chan struct{}
+
+	// The fields below are protected by MutexKV.lock.
+	holder   string
+	acquired time.Time
+	stats    MutexKVStats
+}
+
+// MutexKVStats summarizes how a single key was locked during the run.
+type MutexKVStats struct {
+	Key          string
+	Acquisitions int
+	Contended    int
+	Timeouts     int
+	TotalWait    time.Duration
+	MaxWait      time.Duration
+	TotalHeld    time.Duration
+}
+
+// NewMutexKV returns a properly initialized MutexKV.
+func NewMutexKV() *MutexKV {
+	return &MutexKV{
+		store: make(map[string]*keyLock),
+	}
+}
+
+// SetTimeout sets how long Lock waits for a key held by another caller before
+// returning an error. A zero duration waits indefinitely.
+func (m *MutexKV) SetTimeout(timeout time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.timeout = timeout
+}
+
+// Lock the mutex for the given key. If the key is held by another caller for
+// longer than the configured timeout, an error naming the holder is returned
+// and the key is not locked.
+func (m *MutexKV) Lock(key string) error {
+	caller := mutexKVCaller()
+	kl := m.get(key)
+	start := time.Now()
+
+	select {
+	case kl.sem <- struct{}{}:
+	default:
+		m.lock.Lock()
+		holder, since := kl.holder, time.Since(kl.acquired)
+		timeout := m.timeout
+		kl.stats.Contended++
+		m.lock.Unlock()
+
+		log.Printf("[DEBUG] %s is waiting for lock %q, held by %s for %s", caller, key, holder, since)
+
+		var expired <-chan time.Time
+		if timeout > 0 {
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		select {
+		case kl.sem <- struct{}{}:
+		case <-expired:
+			m.lock.Lock()
+			holder, since = kl.holder, time.Since(kl.acquired)
+			kl.stats.Timeouts++
+			kl.stats.TotalWait += timeout
+			m.lock.Unlock()
+
+			return fmt.Errorf("Timed out after %s waiting for lock %q: held by %s for %s", timeout, key, holder, since)
+		}
+	}
+
+	wait := time.Since(start)
+
+	m.lock.Lock()
+	kl.holder = caller
+	kl.acquired = time.Now()
+	kl.stats.Acquisitions++
+	kl.stats.TotalWait += wait
+	if wait > kl.stats.MaxWait {
+		kl.stats.MaxWait = wait
+	}
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Locking %q for %s (waited %s)", key, caller, wait)
+	return nil
+}
+
+// Unlock the mutex for the given key. Caller must have called Lock for the
+// same key first.
+func (m *MutexKV) Unlock(key string) {
+	kl := m.get(key)
+
+	m.lock.Lock()
+	holder, held := kl.holder, time.Since(kl.acquired)
+	kl.holder = ""
+	kl.stats.TotalHeld += held
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Unlocking %q held by %s for %s", key, holder, held)
+	<-kl.sem
+}
+
+// Stats returns the lock statistics for every key locked so far, sorted by key.
+func (m *MutexKV) Stats() []MutexKVStats {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	stats := make([]MutexKVStats, 0, len(m.store))
+	for key, kl := range m.store {
+		s := kl.stats
+		s.Key = key
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Key < stats[j].Key })
+	return stats
+}
+
+// LogStats logs the lock statistics of every key that another caller had to
+// wait for during the run.
+func (m *MutexKV) LogStats() {
+	for _, s := range m.Stats() {
+		if s.Contended == 0 {
+			continue
+		}
+		log.Printf("[INFO] Lock %q: acquired %d times, contended %d times, %d timeouts, waited %s in total (max %s), held %s in total",
+			s.Key, s.Acquisitions, s.Contended, s.Timeouts, s.TotalWait, s.MaxWait, s.TotalHeld)
+	}
+}
+
+// Returns a mutex for the given key, no guarantee of its lock status.
+func (m *MutexKV) get(key string) *keyLock {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	kl, ok := m.store[key]
+	if !ok {
+		kl = &keyLock{sem: make(chan struct{}, 1)}
+		m.store[key] = kl
+	}
+	return kl
+}
+
+// mutexKVCaller returns the name of the function that called MutexKV.Lock,
+// skipping over helpers that only exist to take a lock on behalf of their
+// own caller.
+func mutexKVCaller() string {
+	pcs := make([]uintptr, 8)
+	// Skip runtime.Callers, mutexKVCaller and MutexKV.Lock.
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	name := "unknown"
+	for {
+		frame, more := frames.Next()
+		// Trim the package path, e.g. "github.com/.../google-beta.resourceComputeRouterCreate"
+		name = frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		name = name[strings.Index(name, ".")+1:]
+		if name != "lockedCall" || !more {
+			break
+		}
+	}
+	return name
+}
+
+// LogMutexKVStats logs the contention statistics of the provider's global
+// MutexKV. It is meant to be called once the provider has finished serving.
+func LogMutexKVStats() {
+	mutexKV.LogStats()
+}
@@ -0,0 +1,109 @@
+package google
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMutexKV_lockUnlock(t *testing.T) {
+	m := NewMutexKV()
+
+	if err := m.Lock("foo"); err != nil {
+		t.Fatalf("error locking: %s", err)
+	}
+
+	locked := make(chan struct{})
+	go func() {
+		if err := m.Lock("foo"); err != nil {
+			t.Errorf("error locking: %s", err)
+		}
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatalf("second Lock returned while the key was held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock("foo")
+
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatalf("second Lock did not return after Unlock")
+	}
+	m.Unlock("foo")
+
+	stats := m.Stats()
+	if len(stats) != 1 {
+		t.Fatalf("expected stats for 1 key, got %d", len(stats))
+	}
+	if stats[0].Key != "foo" || stats[0].Acquisitions != 2 || stats[0].Contended != 1 || stats[0].Timeouts != 0 {
+		t.Fatalf("unexpected stats: %#v", stats[0])
+	}
+	if stats[0].MaxWait <= 0 {
+		t.Fatalf("expected a non-zero wait to be recorded, got %#v", stats[0])
+	}
+}
+
+func TestMutexKV_independentKeys(t *testing.T) {
+	m := NewMutexKV()
+	m.SetTimeout(50 * time.Millisecond)
+
+	if err := m.Lock("foo"); err != nil {
+		t.Fatalf("error locking: %s", err)
+	}
+	defer m.Unlock("foo")
+
+	if err := m.Lock("bar"); err != nil {
+		t.Fatalf("locking a different key should not block: %s", err)
+	}
+	m.Unlock("bar")
+}
+
+func TestMutexKV_timeoutNamesHolder(t *testing.T) {
+	m := NewMutexKV()
+	m.SetTimeout(50 * time.Millisecond)
+
+	if err := m.Lock("foo"); err != nil {
+		t.Fatalf("error locking: %s", err)
+	}
+
+	err := m.Lock("foo")
+	if err == nil {
+		t.Fatalf("expected timeout error")
+	}
+	if !strings.Contains(err.Error(), "TestMutexKV_timeoutNamesHolder") {
+		t.Fatalf("expected error to name the holder, got: %s", err)
+	}
+
+	// The timed out caller must not hold the key.
+	m.Unlock("foo")
+	if err := m.Lock("foo"); err != nil {
+		t.Fatalf("error locking after timeout: %s", err)
+	}
+	m.Unlock("foo")
+
+	stats := m.Stats()
+	if stats[0].Acquisitions != 2 || stats[0].Timeouts != 1 {
+		t.Fatalf("unexpected stats: %#v", stats[0])
+	}
+}
+
+func TestMutexKV_lockedCallHolder(t *testing.T) {
+	m := mutexKV
+	defer func() { mutexKV = m }()
+	mutexKV = NewMutexKV()
+
+	err := lockedCall("foo", func() error {
+		if holder := mutexKV.store["foo"].holder; holder != "TestMutexKV_lockedCallHolder" {
+			t.Errorf("expected holder to skip lockedCall, got %q", holder)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
-	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -13,7 +13,7 @@ import (
 )
 
 // Global MutexKV
-var mutexKV = mutexkv.NewMutexKV()
+var mutexKV = NewMutexKV()
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
@@ -36,7 +36,25 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
 					"GOOGLE_OAUTH_ACCESS_TOKEN",
 				}, nil),
-				ConflictsWith: []string{"credentials"},
+				ConflictsWith: []string{"credentials", "access_token_command", "access_token_file"},
+			},
+
+			"access_token_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_OAUTH_ACCESS_TOKEN_COMMAND",
+				}, nil),
+				ConflictsWith: []string{"credentials", "access_token", "access_token_file"},
+			},
+
+			"access_token_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_OAUTH_ACCESS_TOKEN_FILE",
+				}, nil),
+				ConflictsWith: []string{"credentials", "access_token", "access_token_command"},
 			},
 
 			"project": {
@@ -74,6 +92,15 @@ func Provider() terraform.ResourceProvider {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"lock_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_LOCK_TIMEOUT",
+				}, nil),
+				ValidateFunc: validateDuration(),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -90,6 +117,8 @@ func Provider() terraform.ResourceProvider {
 			"google_compute_forwarding_rule":                  dataSourceGoogleComputeForwardingRule(),
 			"google_compute_image":                            dataSourceGoogleComputeImage(),
 			"google_compute_instance":                         dataSourceGoogleComputeInstance(),
+			"google_compute_instance_serial_port":             dataSourceGoogleComputeInstanceSerialPort(),
+			"google_compute_instance_guest_attributes":        dataSourceGoogleComputeInstanceGuestAttributes(),
 			"google_compute_global_address":                   dataSourceGoogleComputeGlobalAddress(),
 			"google_compute_instance_group":                   dataSourceGoogleComputeInstanceGroup(),
 			"google_compute_lb_ip_ranges":                     dataSourceGoogleComputeLbIpRanges(),
@@ -100,6 +129,7 @@ func Provider() terraform.ResourceProvider {
 			"google_compute_subnetwork":                       dataSourceGoogleComputeSubnetwork(),
 			"google_compute_zones":                            dataSourceGoogleComputeZones(),
 			"google_compute_vpn_gateway":                      dataSourceGoogleComputeVpnGateway(),
+			"google_compute_external_vpn_gateway":             dataSourceGoogleComputeExternalVpnGateway(),
 			"google_compute_ssl_policy":                       dataSourceGoogleComputeSslPolicy(),
 			"google_compute_ssl_certificate":                  dataSourceGoogleComputeSslCertificate(),
 			"google_container_cluster":                        dataSourceGoogleContainerCluster(),
@@ -251,6 +281,7 @@ func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
 			"google_organization_iam_member":               ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
 			"google_organization_iam_policy":               ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
 			"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
+			"google_os_login_ssh_public_key":               resourceOSLoginSSHPublicKey(),
 			"google_project":                               resourceGoogleProject(),
 			"google_project_iam_policy":                    resourceGoogleProjectIamPolicy(),
 			"google_project_iam_binding":                   ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
@@ -301,6 +332,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	// Add credential source
 	if v, ok := d.GetOk("access_token"); ok {
 		config.AccessToken = v.(string)
+	} else if v, ok := d.GetOk("access_token_command"); ok {
+		config.AccessTokenCommand = v.(string)
+	} else if v, ok := d.GetOk("access_token_file"); ok {
+		config.AccessTokenFile = v.(string)
 	} else if v, ok := d.GetOk("credentials"); ok {
 		config.Credentials = v.(string)
 	}
@@ -313,6 +348,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.Scopes[i] = scope.(string)
 	}
 
+	if v, ok := d.GetOk("lock_timeout"); ok {
+		// The duration was checked by validateDuration
+		timeout, _ := time.ParseDuration(v.(string))
+		mutexKV.SetTimeout(timeout)
+	}
+
 	if err := config.LoadAndValidate(); err != nil {
 		return nil, err
 	}
@@ -329,6 +370,12 @@ func validateCredentials(v interface{}, k string) (warnings []string, errors []e
 	if _, err := os.Stat(creds); err == nil {
 		return
 	}
+	if externalAccount, err := parseExternalAccountCredentials([]byte(creds)); err != nil {
+		errors = append(errors, fmt.Errorf("JSON credentials in %q are not valid: %s", creds, err))
+		return
+	} else if externalAccount != nil {
+		return
+	}
 	if _, err := googleoauth.CredentialsFromJSON(context.Background(), []byte(creds)); err != nil {
 		errors = append(errors,
 			fmt.Errorf("JSON credentials in %q are not valid: %s", creds, err))
@@ -17,45 +17,63 @@ package google
 import "github.com/hashicorp/terraform/helper/schema"
 
 var GeneratedComputeResourcesMap = map[string]*schema.Resource{
-	"google_compute_address":                        resourceComputeAddress(),
-	"google_compute_autoscaler":                     resourceComputeAutoscaler(),
-	"google_compute_backend_bucket":                 resourceComputeBackendBucket(),
-	"google_compute_backend_bucket_signed_url_key":  resourceComputeBackendBucketSignedUrlKey(),
-	"google_compute_backend_service":                resourceComputeBackendService(),
-	"google_compute_region_backend_service":         resourceComputeRegionBackendService(),
-	"google_compute_backend_service_signed_url_key": resourceComputeBackendServiceSignedUrlKey(),
-	"google_compute_disk":                           resourceComputeDisk(),
-	"google_compute_firewall":                       resourceComputeFirewall(),
-	"google_compute_forwarding_rule":                resourceComputeForwardingRule(),
-	"google_compute_global_address":                 resourceComputeGlobalAddress(),
-	"google_compute_global_forwarding_rule":         resourceComputeGlobalForwardingRule(),
-	"google_compute_http_health_check":              resourceComputeHttpHealthCheck(),
-	"google_compute_https_health_check":             resourceComputeHttpsHealthCheck(),
-	"google_compute_health_check":                   resourceComputeHealthCheck(),
-	"google_compute_image":                          resourceComputeImage(),
-	"google_compute_interconnect_attachment":        resourceComputeInterconnectAttachment(),
-	"google_compute_network":                        resourceComputeNetwork(),
-	"google_compute_network_endpoint":               resourceComputeNetworkEndpoint(),
-	"google_compute_network_endpoint_group":         resourceComputeNetworkEndpointGroup(),
-	"google_compute_node_group":                     resourceComputeNodeGroup(),
-	"google_compute_node_template":                  resourceComputeNodeTemplate(),
-	"google_compute_region_autoscaler":              resourceComputeRegionAutoscaler(),
-	"google_compute_region_disk":                    resourceComputeRegionDisk(),
-	"google_compute_resource_policy":                resourceComputeResourcePolicy(),
-	"google_compute_route":                          resourceComputeRoute(),
-	"google_compute_router":                         resourceComputeRouter(),
-	"google_compute_snapshot":                       resourceComputeSnapshot(),
-	"google_compute_ssl_certificate":                resourceComputeSslCertificate(),
-	"google_compute_managed_ssl_certificate":        resourceComputeManagedSslCertificate(),
-	"google_compute_ssl_policy":                     resourceComputeSslPolicy(),
-	"google_compute_subnetwork":                     resourceComputeSubnetwork(),
-	"google_compute_target_http_proxy":              resourceComputeTargetHttpProxy(),
-	"google_compute_target_https_proxy":             resourceComputeTargetHttpsProxy(),
-	"google_compute_target_instance":                resourceComputeTargetInstance(),
-	"google_compute_target_ssl_proxy":               resourceComputeTargetSslProxy(),
-	"google_compute_target_tcp_proxy":               resourceComputeTargetTcpProxy(),
-	"google_compute_vpn_gateway":                    resourceComputeVpnGateway(),
-	"google_compute_ha_vpn_gateway":                 resourceComputeHaVpnGateway(),
-	"google_compute_url_map":                        resourceComputeUrlMap(),
-	"google_compute_vpn_tunnel":                     resourceComputeVpnTunnel(),
+	"google_compute_address":                         resourceComputeAddress(),
+	"google_compute_autoscaler":                      resourceComputeAutoscaler(),
+	"google_compute_backend_bucket":                  resourceComputeBackendBucket(),
+	"google_compute_backend_bucket_signed_url_key":   resourceComputeBackendBucketSignedUrlKey(),
+	"google_compute_backend_service":                 resourceComputeBackendService(),
+	"google_compute_region_backend_service":          resourceComputeRegionBackendService(),
+	"google_compute_backend_service_signed_url_key":  resourceComputeBackendServiceSignedUrlKey(),
+	"google_compute_disk":                            resourceComputeDisk(),
+	"google_compute_disk_resource_policy_attachment": resourceComputeDiskResourcePolicyAttachment(),
+	"google_compute_firewall":                        resourceComputeFirewall(),
+	"google_compute_firewall_policy":                 resourceComputeFirewallPolicy(),
+	"google_compute_firewall_policy_rule":            resourceComputeFirewallPolicyRule(),
+	"google_compute_firewall_policy_association":     resourceComputeFirewallPolicyAssociation(),
+	"google_compute_forwarding_rule":                 resourceComputeForwardingRule(),
+	"google_compute_global_address":                  resourceComputeGlobalAddress(),
+	"google_compute_global_forwarding_rule":          resourceComputeGlobalForwardingRule(),
+	"google_compute_global_network_endpoint":         resourceComputeGlobalNetworkEndpoint(),
+	"google_compute_global_network_endpoint_group":   resourceComputeGlobalNetworkEndpointGroup(),
+	"google_compute_http_health_check":               resourceComputeHttpHealthCheck(),
+	"google_compute_https_health_check":              resourceComputeHttpsHealthCheck(),
+	"google_compute_health_check":                    resourceComputeHealthCheck(),
+	"google_compute_image":                           resourceComputeImage(),
+	"google_compute_interconnect_attachment":         resourceComputeInterconnectAttachment(),
+	"google_compute_machine_image":                   resourceComputeMachineImage(),
+	"google_compute_network":                         resourceComputeNetwork(),
+	"google_compute_network_endpoint":                resourceComputeNetworkEndpoint(),
+	"google_compute_network_endpoint_group":          resourceComputeNetworkEndpointGroup(),
+	"google_compute_per_instance_config":             resourceComputePerInstanceConfig(),
+	"google_compute_node_group":                      resourceComputeNodeGroup(),
+	"google_compute_node_template":                   resourceComputeNodeTemplate(),
+	"google_compute_packet_mirroring":                resourceComputePacketMirroring(),
+	"google_compute_region_autoscaler":               resourceComputeRegionAutoscaler(),
+	"google_compute_region_disk":                     resourceComputeRegionDisk(),
+	"google_compute_region_health_check":             resourceComputeRegionHealthCheck(),
+	"google_compute_region_network_endpoint_group":   resourceComputeRegionNetworkEndpointGroup(),
+	"google_compute_region_per_instance_config":      resourceComputeRegionPerInstanceConfig(),
+	"google_compute_region_ssl_certificate":          resourceComputeRegionSslCertificate(),
+	"google_compute_region_target_http_proxy":        resourceComputeRegionTargetHttpProxy(),
+	"google_compute_region_target_https_proxy":       resourceComputeRegionTargetHttpsProxy(),
+	"google_compute_region_url_map":                  resourceComputeRegionUrlMap(),
+	"google_compute_reservation":                     resourceComputeReservation(),
+	"google_compute_resource_policy":                 resourceComputeResourcePolicy(),
+	"google_compute_route":                           resourceComputeRoute(),
+	"google_compute_router":                          resourceComputeRouter(),
+	"google_compute_snapshot":                        resourceComputeSnapshot(),
+	"google_compute_ssl_certificate":                 resourceComputeSslCertificate(),
+	"google_compute_managed_ssl_certificate":         resourceComputeManagedSslCertificate(),
+	"google_compute_ssl_policy":                      resourceComputeSslPolicy(),
+	"google_compute_subnetwork":                      resourceComputeSubnetwork(),
+	"google_compute_target_http_proxy":               resourceComputeTargetHttpProxy(),
+	"google_compute_target_https_proxy":              resourceComputeTargetHttpsProxy(),
+	"google_compute_target_instance":                 resourceComputeTargetInstance(),
+	"google_compute_target_ssl_proxy":                resourceComputeTargetSslProxy(),
+	"google_compute_target_tcp_proxy":                resourceComputeTargetTcpProxy(),
+	"google_compute_vpn_gateway":                     resourceComputeVpnGateway(),
+	"google_compute_ha_vpn_gateway":                  resourceComputeHaVpnGateway(),
+	"google_compute_external_vpn_gateway":            resourceComputeExternalVpnGateway(),
+	"google_compute_url_map":                         resourceComputeUrlMap(),
+	"google_compute_vpn_tunnel":                      resourceComputeVpnTunnel(),
 }
@@ -15,6 +15,7 @@ func resourceBigtableInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigtableInstanceCreate,
 		Read:   resourceBigtableInstanceRead,
+		Update: resourceBigtableInstanceUpdate,
 		Delete: resourceBigtableInstanceDestroy,
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +81,12 @@ func resourceBigtableInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"cluster_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -207,10 +214,20 @@ func resourceBigtableInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// Every attribute sent to the API forces a new instance, so the only
+// updatable field is deletion_protection, which lives in state alone.
+func resourceBigtableInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceBigtableInstanceRead(d, meta)
+}
+
 func resourceBigtableInstanceDestroy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	ctx := context.Background()
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete Bigtable instance %s: deletion_protection is enabled. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", d.Id())
+	}
+
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
@@ -142,6 +142,7 @@ resource "google_bigtable_instance" "instance" {
 		num_nodes    = %d
 		storage_type = "HDD"
 	}
+	deletion_protection = false
 }
 `, instanceName, instanceName, numNodes)
 }
@@ -162,6 +163,7 @@ resource "google_bigtable_instance" "instance" {
 		num_nodes    = 3
 		storage_type = "HDD"
 	}
+	deletion_protection = false
 }
 `, instanceName, instanceName, instanceName)
 }
@@ -175,6 +177,7 @@ resource "google_bigtable_instance" "instance" {
 		zone          = "us-central1-b"
 	}
 	instance_type = "DEVELOPMENT"
+	deletion_protection = false
 }
 `, instanceName, instanceName)
 }
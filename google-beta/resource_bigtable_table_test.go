@@ -162,6 +162,7 @@ resource "google_bigtable_instance" "instance" {
     cluster_id = "%s"
     zone       = "us-central1-b"
   }
+  deletion_protection = false
 }
 
 resource "google_bigtable_table" "table" {
@@ -180,6 +181,7 @@ resource "google_bigtable_instance" "instance" {
     cluster_id = "%s"
     zone       = "us-central1-b"
   }
+  deletion_protection = false
 }
 
 resource "google_bigtable_table" "table" {
@@ -201,6 +203,7 @@ resource "google_bigtable_instance" "instance" {
   }
 
   instance_type = "DEVELOPMENT"
+  deletion_protection = false
 }
 
 resource "google_bigtable_table" "table" {
@@ -225,6 +228,7 @@ resource "google_bigtable_instance" "instance" {
   }
 
   instance_type = "DEVELOPMENT"
+  deletion_protection = false
 }
 
 resource "google_bigtable_table" "table" {
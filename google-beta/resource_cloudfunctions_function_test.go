@@ -479,6 +479,7 @@ func testAccCloudFunctionsFunction_basic(functionName string, bucketName string,
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
@@ -511,6 +512,7 @@ func testAccCloudFunctionsFunction_updated(functionName string, bucketName strin
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
@@ -546,6 +548,7 @@ func testAccCloudFunctionsFunction_pubsub(functionName string, bucketName string
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
@@ -581,6 +584,7 @@ func testAccCloudFunctionsFunction_bucket(functionName string, bucketName string
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
@@ -612,6 +616,7 @@ func testAccCloudFunctionsFunction_bucketNoRetry(functionName string, bucketName
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
@@ -639,6 +644,7 @@ func testAccCloudFunctionsFunction_firestore(functionName string, bucketName str
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
@@ -684,6 +690,7 @@ func testAccCloudFunctionsFunction_serviceAccountEmail(functionName, bucketName,
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "archive" {
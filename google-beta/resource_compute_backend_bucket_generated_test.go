@@ -60,6 +60,7 @@ resource "google_compute_backend_bucket" "image_backend" {
 resource "google_storage_bucket" "image_bucket" {
   name     = "image-store-bucket-%{random_suffix}"
   location = "EU"
+  deletion_protection = false
 }
 `, context)
 }
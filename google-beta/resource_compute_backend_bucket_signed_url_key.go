@@ -91,7 +91,9 @@ func resourceComputeBackendBucketSignedUrlKeyCreate(d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/backendBuckets/{{backend_bucket}}/addSignedUrlKey")
@@ -184,7 +186,9 @@ func resourceComputeBackendBucketSignedUrlKeyDelete(d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/backendBuckets/{{backend_bucket}}/deleteSignedUrlKey?keyName={{name}}")
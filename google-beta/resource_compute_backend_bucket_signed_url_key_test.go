@@ -48,6 +48,7 @@ resource "google_compute_backend_bucket" "test_backend" {
 resource "google_storage_bucket" "bucket" {
   name     = "test-storage-bucket-%{random_suffix}"
   location = "EU"
+  deletion_protection = false
 }
 `, context)
 }
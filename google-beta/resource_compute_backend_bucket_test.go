@@ -74,6 +74,7 @@ resource "google_compute_backend_bucket" "foobar" {
 resource "google_storage_bucket" "bucket_one" {
   name     = "%s"
   location = "EU"
+  deletion_protection = false
 }
 `, backendName, storageName)
 }
@@ -88,11 +89,13 @@ resource "google_compute_backend_bucket" "foobar" {
 resource "google_storage_bucket" "bucket_one" {
   name     = "%s"
   location = "EU"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket" "bucket_two" {
   name     = "%s"
   location = "EU"
+  deletion_protection = false
 }
 `, backendName, bucketOne, bucketTwo)
 }
@@ -111,6 +114,7 @@ resource "google_compute_backend_bucket" "foobar" {
 resource "google_storage_bucket" "bucket" {
   name     = "%s"
   location = "EU"
+  deletion_protection = false
 }
 `, backendName, storageName)
 }
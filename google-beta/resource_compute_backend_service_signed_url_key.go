@@ -91,7 +91,9 @@ func resourceComputeBackendServiceSignedUrlKeyCreate(d *schema.ResourceData, met
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/backendServices/{{backend_service}}/addSignedUrlKey")
@@ -184,7 +186,9 @@ func resourceComputeBackendServiceSignedUrlKeyDelete(d *schema.ResourceData, met
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/backendServices/{{backend_service}}/deleteSignedUrlKey?keyName={{name}}")
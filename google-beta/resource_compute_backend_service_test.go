@@ -660,6 +660,68 @@ func TestAccComputeBackendService_internalLoadBalancing(t *testing.T) {
 	})
 }
 
+func TestAccComputeBackendService_trafficDirectorUpdateBasic(t *testing.T) {
+	t.Parallel()
+
+	backendName := fmt.Sprintf("foo-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("bar-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeBackendService_trafficDirectorBasic(backendName, checkName),
+			},
+			{
+				ResourceName:      "google_compute_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeBackendService_trafficDirectorUpdateBasic(backendName, checkName),
+			},
+			{
+				ResourceName:      "google_compute_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeBackendService_withLogConfig(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeBackendService_withLogConfig(serviceName, checkName, 0.7),
+			},
+			{
+				ResourceName:      "google_compute_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeBackendService_withLogConfig(serviceName, checkName, 0.4),
+			},
+			{
+				ResourceName:      "google_compute_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccComputeBackendService_basic(serviceName, checkName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_service" "foobar" {
@@ -1179,3 +1241,111 @@ resource "google_compute_instance_template" "foobar" {
   }
 }`, fr, proxy, backend, hc, urlmap)
 }
+
+func testAccComputeBackendService_trafficDirectorBasic(serviceName, checkName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "foobar" {
+  name                  = "%s"
+  health_checks         = ["${google_compute_health_check.health_check.self_link}"]
+  load_balancing_scheme = "INTERNAL_SELF_MANAGED"
+  locality_lb_policy    = "RING_HASH"
+  session_affinity      = "HTTP_COOKIE"
+
+  circuit_breakers {
+    max_connections = 10
+  }
+
+  consistent_hash {
+    http_cookie {
+      ttl {
+        seconds = 11
+        nanos   = 1234
+      }
+      name = "mycookie"
+    }
+  }
+
+  outlier_detection {
+    consecutive_errors = 2
+  }
+}
+
+resource "google_compute_health_check" "health_check" {
+  name = "%s"
+
+  http_health_check {
+    port = 80
+  }
+}
+`, serviceName, checkName)
+}
+
+func testAccComputeBackendService_trafficDirectorUpdateBasic(serviceName, checkName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "foobar" {
+  name                  = "%s"
+  health_checks         = ["${google_compute_health_check.health_check.self_link}"]
+  load_balancing_scheme = "INTERNAL_SELF_MANAGED"
+  locality_lb_policy    = "RANDOM"
+
+  circuit_breakers {
+    connect_timeout {
+      seconds = 3
+    }
+    max_requests_per_connection = 4
+    max_connections             = 5
+    max_pending_requests        = 6
+    max_requests                = 7
+    max_retries                 = 8
+  }
+
+  outlier_detection {
+    base_ejection_time {
+      seconds = 0
+      nanos   = 5
+    }
+    consecutive_errors                    = 1
+    consecutive_gateway_failure           = 3
+    enforcing_consecutive_errors          = 4
+    enforcing_consecutive_gateway_failure = 5
+    enforcing_success_rate                = 6
+    interval {
+      seconds = 7
+    }
+    max_ejection_percent        = 99
+    success_rate_minimum_hosts  = 98
+    success_rate_request_volume = 97
+    success_rate_stdev_factor   = 1800
+  }
+}
+
+resource "google_compute_health_check" "health_check" {
+  name = "%s"
+
+  http_health_check {
+    port = 80
+  }
+}
+`, serviceName, checkName)
+}
+
+func testAccComputeBackendService_withLogConfig(serviceName, checkName string, sampleRate float64) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "foobar" {
+  name          = "%s"
+  health_checks = ["${google_compute_http_health_check.zero.self_link}"]
+
+  log_config {
+    enable      = true
+    sample_rate = %v
+  }
+}
+
+resource "google_compute_http_health_check" "zero" {
+  name               = "%s"
+  request_path       = "/"
+  check_interval_sec = 1
+  timeout_sec        = 1
+}
+`, serviceName, sampleRate, checkName)
+}
@@ -0,0 +1,343 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeDiskResourcePolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeDiskResourcePolicyAttachmentCreate,
+		Read:   resourceComputeDiskResourcePolicyAttachmentRead,
+		Delete: resourceComputeDiskResourcePolicyAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeDiskResourcePolicyAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disk": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeDiskResourcePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeDiskResourcePolicyAttachmentName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+
+	obj, err = resourceComputeDiskResourcePolicyAttachmentEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	lockName, err := replaceVars(d, config, "diskResourcePolicies/{{project}}/{{zone}}/{{disk}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/disks/{{disk}}/addResourcePolicies")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new DiskResourcePolicyAttachment: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating DiskResourcePolicyAttachment: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{disk}}/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config.clientCompute, op, project, "Creating DiskResourcePolicyAttachment",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create DiskResourcePolicyAttachment: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating DiskResourcePolicyAttachment %q: %#v", d.Id(), res)
+
+	return resourceComputeDiskResourcePolicyAttachmentRead(d, meta)
+}
+
+func resourceComputeDiskResourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/disks/{{disk}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDiskResourcePolicyAttachment %q", d.Id()))
+	}
+
+	res, err = flattenNestedComputeDiskResourcePolicyAttachment(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	if res == nil {
+		// Object isn't there any more - remove it from the state.
+		log.Printf("[DEBUG] Removing ComputeDiskResourcePolicyAttachment because it couldn't be matched.")
+		d.SetId("")
+		return nil
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading DiskResourcePolicyAttachment: %s", err)
+	}
+
+	if err := d.Set("name", flattenComputeDiskResourcePolicyAttachmentName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading DiskResourcePolicyAttachment: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeDiskResourcePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	lockName, err := replaceVars(d, config, "diskResourcePolicies/{{project}}/{{zone}}/{{disk}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/disks/{{disk}}/removeResourcePolicies")
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeDiskResourcePolicyAttachmentName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	}
+	obj["name"] = nameProp
+
+	obj, err = resourceComputeDiskResourcePolicyAttachmentEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DiskResourcePolicyAttachment %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "DiskResourcePolicyAttachment")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Deleting DiskResourcePolicyAttachment",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting DiskResourcePolicyAttachment %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeDiskResourcePolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/disks/(?P<disk>[^/]+)/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)", "(?P<zone>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)", "(?P<disk>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{disk}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeDiskResourcePolicyAttachmentName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandComputeDiskResourcePolicyAttachmentName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func resourceComputeDiskResourcePolicyAttachmentEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	config := meta.(*Config)
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return nil, err
+	}
+	if zone == "" {
+		return nil, fmt.Errorf("zone must be non-empty - set in resource or at provider-level")
+	}
+
+	// resourcePolicies are referred to by region but affixed to zonal disks.
+	// We construct the regional name from the zone:
+	//    projects/{project}/regions/{region}/resourcePolicies/{resourceId}
+	region := getRegionFromZone(zone)
+	if region == "" {
+		return nil, fmt.Errorf("invalid zone %q, unable to infer region from zone", zone)
+	}
+
+	obj["resourcePolicies"] = []interface{}{fmt.Sprintf("projects/%s/regions/%s/resourcePolicies/%s", project, region, obj["name"])}
+	delete(obj, "name")
+	return obj, nil
+}
+
+func flattenNestedComputeDiskResourcePolicyAttachment(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	var v interface{}
+	var ok bool
+
+	v, ok = res["resourcePolicies"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	switch v.(type) {
+	case []interface{}:
+		break
+	case map[string]interface{}:
+		// Construct list out of single nested resource
+		v = []interface{}{v}
+	default:
+		return nil, fmt.Errorf("expected list or map for value resourcePolicies. Actual value: %v", v)
+	}
+
+	expectedName, err := expandComputeDiskResourcePolicyAttachmentName(d.Get("name"), d, meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+
+	// Search list for this resource.
+	items := v.([]interface{})
+	for _, itemRaw := range items {
+		if itemRaw == nil {
+			continue
+		}
+		// List response only contains the self links of the policies.
+		item := map[string]interface{}{
+			"name": itemRaw,
+		}
+
+		// Decode list item before comparing.
+		item, err := resourceComputeDiskResourcePolicyAttachmentDecoder(d, meta, item)
+		if err != nil {
+			return nil, err
+		}
+
+		itemName := flattenComputeDiskResourcePolicyAttachmentName(item["name"], d)
+		if !reflect.DeepEqual(itemName, expectedName) {
+			log.Printf("[DEBUG] Skipping item with name= %#v, looking for %#v)", itemName, expectedName)
+			continue
+		}
+		log.Printf("[DEBUG] Found item for resource %q: %#v)", d.Id(), item)
+		return item, nil
+	}
+
+	return nil, nil
+}
+
+func resourceComputeDiskResourcePolicyAttachmentDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	res["name"] = GetResourceNameFromSelfLink(res["name"].(string))
+	return res, nil
+}
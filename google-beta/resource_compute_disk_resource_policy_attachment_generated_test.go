@@ -0,0 +1,115 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeDiskResourcePolicyAttachment_diskResourcePolicyAttachmentBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersOiCS,
+		CheckDestroy: testAccCheckComputeDiskResourcePolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskResourcePolicyAttachment_diskResourcePolicyAttachmentBasicExample(context),
+			},
+		},
+	})
+}
+
+func testAccComputeDiskResourcePolicyAttachment_diskResourcePolicyAttachmentBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_disk_resource_policy_attachment" "attachment" {
+  provider = "google-beta"
+  name = "${google_compute_resource_policy.policy.name}"
+  disk = "${google_compute_disk.ssd.name}"
+  zone = "us-central1-a"
+}
+
+data "google_compute_image" "my_image" {
+  provider = "google-beta"
+  family  = "debian-9"
+  project = "debian-cloud"
+}
+
+resource "google_compute_disk" "ssd" {
+  provider = "google-beta"
+  name  = "my-disk-%{random_suffix}"
+  image = "${data.google_compute_image.my_image.self_link}"
+  size  = 50
+  type  = "pd-ssd"
+  zone  = "us-central1-a"
+}
+
+resource "google_compute_resource_policy" "policy" {
+  provider = "google-beta"
+  name = "my-resource-policy-%{random_suffix}"
+  region = "us-central1"
+  snapshot_schedule_policy {
+    schedule {
+      daily_schedule {
+        days_in_cycle = 1
+        start_time = "04:00"
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckComputeDiskResourcePolicyAttachmentDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_disk_resource_policy_attachment" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/disks/{{disk}}")
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			// The disk is gone, so the attachment is too.
+			continue
+		}
+		for _, policy := range res["resourcePolicies"].([]interface{}) {
+			if GetResourceNameFromSelfLink(policy.(string)) == rs.Primary.Attributes["name"] {
+				return fmt.Errorf("ComputeDiskResourcePolicyAttachment still exists at %s", url)
+			}
+		}
+	}
+
+	return nil
+}
@@ -256,6 +256,32 @@ func TestAccComputeDisk_update(t *testing.T) {
 	})
 }
 
+func TestAccComputeDisk_resourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	policyName := fmt.Sprintf("tf-test-policy-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDisk_resourcePolicies(diskName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_disk.foobar", "resource_policies.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_disk.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeDisk_fromSnapshot(t *testing.T) {
 	t.Parallel()
 
@@ -556,6 +582,36 @@ resource "google_compute_disk" "foobar" {
 }`, diskName)
 }
 
+func testAccComputeDisk_resourcePolicies(diskName, policyName string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_resource_policy" "foo" {
+	name   = "%s"
+	region = "us-central1"
+	snapshot_schedule_policy {
+		schedule {
+			daily_schedule {
+				days_in_cycle = 1
+				start_time    = "04:00"
+			}
+		}
+	}
+}
+
+resource "google_compute_disk" "foobar" {
+	name              = "%s"
+	image             = "${data.google_compute_image.my_image.self_link}"
+	size              = 50
+	type              = "pd-ssd"
+	zone              = "us-central1-a"
+	resource_policies = ["${google_compute_resource_policy.foo.self_link}"]
+}`, policyName, diskName)
+}
+
 func testAccComputeDisk_timeout(diskName string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
@@ -0,0 +1,139 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeExternalVpnGateway_externalVpnGatewayExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersOiCS,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeExternalVpnGateway_externalVpnGatewayExample(context),
+			},
+		},
+	})
+}
+
+func testAccComputeExternalVpnGateway_externalVpnGatewayExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_ha_vpn_gateway" "ha_gateway" {
+  provider = "google-beta"
+  region   = "us-central1"
+  name     = "ha-vpn-%{random_suffix}"
+  network  = "${google_compute_network.network.self_link}"
+}
+
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  provider        = "google-beta"
+  name            = "external-gateway-%{random_suffix}"
+  redundancy_type = "SINGLE_IP_INTERNALLY_REDUNDANT"
+  description     = "An externally managed VPN gateway"
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+}
+
+resource "google_compute_network" "network" {
+  provider                = "google-beta"
+  name                    = "network-%{random_suffix}"
+  routing_mode            = "GLOBAL"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "network_subnet1" {
+  provider      = "google-beta"
+  name          = "ha-vpn-subnet-1-%{random_suffix}"
+  ip_cidr_range = "10.0.1.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.network.self_link}"
+}
+
+resource "google_compute_router" "router1" {
+  provider = "google-beta"
+  name     = "ha-vpn-router1-%{random_suffix}"
+  network  = "${google_compute_network.network.name}"
+  bgp {
+    asn = 64514
+  }
+}
+
+resource "google_compute_vpn_tunnel" "tunnel1" {
+  provider                        = "google-beta"
+  name                            = "ha-vpn-tunnel1-%{random_suffix}"
+  region                          = "us-central1"
+  vpn_gateway                     = "${google_compute_ha_vpn_gateway.ha_gateway.self_link}"
+  peer_external_gateway           = "${google_compute_external_vpn_gateway.external_gateway.self_link}"
+  peer_external_gateway_interface = 0
+  shared_secret                   = "a secret message"
+  router                          = "${google_compute_router.router1.self_link}"
+  vpn_gateway_interface           = 0
+}
+
+resource "google_compute_vpn_tunnel" "tunnel2" {
+  provider                        = "google-beta"
+  name                            = "ha-vpn-tunnel2-%{random_suffix}"
+  region                          = "us-central1"
+  vpn_gateway                     = "${google_compute_ha_vpn_gateway.ha_gateway.self_link}"
+  peer_external_gateway           = "${google_compute_external_vpn_gateway.external_gateway.self_link}"
+  peer_external_gateway_interface = 0
+  shared_secret                   = "a secret message"
+  router                          = " ${google_compute_router.router1.self_link}"
+  vpn_gateway_interface           = 1
+}
+`, context)
+}
+
+func testAccCheckComputeExternalVpnGatewayDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_external_vpn_gateway" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/externalVpnGateways/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeExternalVpnGateway still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeExternalVpnGateway_updateLabels(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-external-gateway-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeExternalVpnGateway_twoInterfaces(name, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_external_vpn_gateway.foobar", "labels.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_external_vpn_gateway.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeExternalVpnGateway_twoInterfaces(name, "baz", "qux"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_external_vpn_gateway.foobar", "labels.baz", "qux"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_external_vpn_gateway.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeExternalVpnGateway_twoInterfaces(name, key, value string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "foobar" {
+	name            = "%s"
+	redundancy_type = "TWO_IPS_REDUNDANCY"
+	description     = "An externally managed VPN gateway"
+
+	interface {
+		id         = 0
+		ip_address = "8.8.8.8"
+	}
+
+	interface {
+		id         = 1
+		ip_address = "8.8.4.4"
+	}
+
+	labels = {
+		%s = "%s"
+	}
+}
+`, name, key, value)
+}
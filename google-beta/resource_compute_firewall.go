@@ -48,6 +48,33 @@ func resourceComputeFirewallRuleHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+// Firewalls that only set the deprecated enable_logging field get logging
+// with the API's default metadata. Don't show a diff against the log_config
+// that is read back for them.
+func diffSuppressComputeFirewallLegacyLogConfig(k, old, new string, d *schema.ResourceData) bool {
+	if !d.Get("enable_logging").(bool) {
+		return false
+	}
+	if _, ok := d.GetOk("log_config"); ok {
+		return false
+	}
+
+	switch k {
+	case "log_config.#":
+		return old == "1" && new == "0"
+	case "log_config.0.metadata":
+		return old == "INCLUDE_ALL_METADATA" && new == ""
+	}
+	return false
+}
+
+// enable_logging is implied by the presence of log_config, so ignore it when
+// the block is set.
+func diffSuppressComputeFirewallEnableLogging(k, old, new string, d *schema.ResourceData) bool {
+	_, ok := d.GetOk("log_config")
+	return ok
+}
+
 func resourceComputeFirewall() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeFirewallCreate,
@@ -65,7 +92,7 @@ func resourceComputeFirewall() *schema.Resource {
 			Delete: schema.DefaultTimeout(240 * time.Second),
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		MigrateState:  resourceComputeFirewallMigrateState,
 
 		Schema: map[string]*schema.Schema{
@@ -120,8 +147,26 @@ func resourceComputeFirewall() *schema.Resource {
 				Optional: true,
 			},
 			"enable_logging": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Deprecated:       "Deprecated in favor of log_config",
+				DiffSuppressFunc: diffSuppressComputeFirewallEnableLogging,
+			},
+			"log_config": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: diffSuppressComputeFirewallLegacyLogConfig,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringInSlice([]string{"EXCLUDE_ALL_METADATA", "INCLUDE_ALL_METADATA"}, false),
+							DiffSuppressFunc: diffSuppressComputeFirewallLegacyLogConfig,
+						},
+					},
+				},
 			},
 			"priority": {
 				Type:         schema.TypeInt,
@@ -271,11 +316,11 @@ func resourceComputeFirewallCreate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("disabled"); ok || !reflect.DeepEqual(v, disabledProp) {
 		obj["disabled"] = disabledProp
 	}
-	enableLoggingProp, err := expandComputeFirewallEnableLogging(d.Get("enable_logging"), d, config)
+	logConfigProp, err := expandComputeFirewallLogConfig(d.Get("log_config"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("enable_logging"); ok || !reflect.DeepEqual(v, enableLoggingProp) {
-		obj["enableLogging"] = enableLoggingProp
+	} else if v, ok := d.GetOkExists("log_config"); !isEmptyValue(reflect.ValueOf(logConfigProp)) && (ok || !reflect.DeepEqual(v, logConfigProp)) {
+		obj["logConfig"] = logConfigProp
 	}
 	nameProp, err := expandComputeFirewallName(d.Get("name"), d, config)
 	if err != nil {
@@ -411,7 +456,10 @@ func resourceComputeFirewallRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("disabled", flattenComputeFirewallDisabled(res["disabled"], d)); err != nil {
 		return fmt.Errorf("Error reading Firewall: %s", err)
 	}
-	if err := d.Set("enable_logging", flattenComputeFirewallEnableLogging(res["enableLogging"], d)); err != nil {
+	if err := d.Set("enable_logging", flattenComputeFirewallEnableLogging(res["logConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Firewall: %s", err)
+	}
+	if err := d.Set("log_config", flattenComputeFirewallLogConfig(res["logConfig"], d)); err != nil {
 		return fmt.Errorf("Error reading Firewall: %s", err)
 	}
 	if err := d.Set("name", flattenComputeFirewallName(res["name"], d)); err != nil {
@@ -479,11 +527,11 @@ func resourceComputeFirewallUpdate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("disabled"); ok || !reflect.DeepEqual(v, disabledProp) {
 		obj["disabled"] = disabledProp
 	}
-	enableLoggingProp, err := expandComputeFirewallEnableLogging(d.Get("enable_logging"), d, config)
+	logConfigProp, err := expandComputeFirewallLogConfig(d.Get("log_config"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("enable_logging"); ok || !reflect.DeepEqual(v, enableLoggingProp) {
-		obj["enableLogging"] = enableLoggingProp
+	} else if v, ok := d.GetOkExists("log_config"); ok || !reflect.DeepEqual(v, logConfigProp) {
+		obj["logConfig"] = logConfigProp
 	}
 	networkProp, err := expandComputeFirewallNetwork(d.Get("network"), d, config)
 	if err != nil {
@@ -691,7 +739,37 @@ func flattenComputeFirewallDisabled(v interface{}, d *schema.ResourceData) inter
 	return v
 }
 
+// enable_logging is read from logConfig.enable, which is what Create and Update
+// write, rather than from the deprecated enableLogging field.
 func flattenComputeFirewallEnableLogging(v interface{}, d *schema.ResourceData) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	enable, _ := original["enable"].(bool)
+	return enable
+}
+
+func flattenComputeFirewallLogConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+
+	// The block's presence stands in for logConfig.enable.
+	if enable, ok := original["enable"].(bool); !ok || !enable {
+		return nil
+	}
+
+	transformed := make(map[string]interface{})
+	transformed["metadata"] =
+		flattenComputeFirewallLogConfigMetadata(original["metadata"], d)
+	return []interface{}{transformed}
+}
+func flattenComputeFirewallLogConfigMetadata(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
 
@@ -844,7 +922,31 @@ func expandComputeFirewallDisabled(v interface{}, d TerraformResourceData, confi
 	return v, nil
 }
 
-func expandComputeFirewallEnableLogging(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+func expandComputeFirewallLogConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	transformed := make(map[string]interface{})
+
+	// log_config has no enable field; setting the block turns logging on.
+	// Without it, fall back to the deprecated enable_logging field.
+	if len(l) == 0 || l[0] == nil {
+		transformed["enable"] = d.Get("enable_logging")
+		return transformed, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+
+	transformed["enable"] = true
+	transformedMetadata, err := expandComputeFirewallLogConfigMetadata(original["metadata"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMetadata); val.IsValid() && !isEmptyValue(val) {
+		transformed["metadata"] = transformedMetadata
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFirewallLogConfigMetadata(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
 
@@ -17,10 +17,19 @@ func resourceComputeFirewallMigrateState(
 		return is, nil
 	}
 
+	var err error
+
 	switch v {
 	case 0:
 		log.Println("[INFO] Found Compute Firewall State v0; migrating to v1")
-		is, err := migrateFirewallStateV0toV1(is)
+		is, err = migrateFirewallStateV0toV1(is)
+		if err != nil {
+			return is, err
+		}
+		fallthrough
+	case 1:
+		log.Println("[INFO] Found Compute Firewall State v1; migrating to v2")
+		is, err = migrateFirewallStateV1toV2(is)
 		if err != nil {
 			return is, err
 		}
@@ -91,3 +100,20 @@ func migrateFirewallStateV0toV1(is *terraform.InstanceState) (*terraform.Instanc
 	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
 	return is, nil
 }
+
+// migrateFirewallStateV1toV2 records firewalls that had enable_logging set as
+// having the log_config the API gives them by default, so that state matches
+// what's read back now that log_config is exposed.
+func migrateFirewallStateV1toV2(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	if is.Attributes["enable_logging"] == "true" {
+		if _, ok := is.Attributes["log_config.#"]; !ok {
+			is.Attributes["log_config.#"] = "1"
+			is.Attributes["log_config.0.metadata"] = "INCLUDE_ALL_METADATA"
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
@@ -34,6 +34,27 @@ func TestComputeFirewallMigrateState(t *testing.T) {
 				"allow.0.ports.3":  "4044",
 			},
 		},
+		"add log_config for enabled logging": {
+			StateVersion: 1,
+			Attributes: map[string]string{
+				"enable_logging": "true",
+			},
+			Expected: map[string]string{
+				"enable_logging":        "true",
+				"log_config.#":          "1",
+				"log_config.0.metadata": "INCLUDE_ALL_METADATA",
+			},
+		},
+		"no log_config for disabled logging": {
+			StateVersion: 1,
+			Attributes: map[string]string{
+				"enable_logging": "false",
+			},
+			Expected: map[string]string{
+				"enable_logging": "false",
+				"log_config.#":   "",
+			},
+		},
 	}
 	for tn, tc := range cases {
 		is := &terraform.InstanceState{
@@ -0,0 +1,381 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeFirewallPolicyCreate,
+		Read:   resourceComputeFirewallPolicyRead,
+		Update: resourceComputeFirewallPolicyUpdate,
+		Delete: resourceComputeFirewallPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeFirewallPolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"short_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGCPName,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"firewall_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_tuple_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"self_link_with_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	shortNameProp, err := expandComputeFirewallPolicyShortName(d.Get("short_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("short_name"); !isEmptyValue(reflect.ValueOf(shortNameProp)) && (ok || !reflect.DeepEqual(v, shortNameProp)) {
+		obj["shortName"] = shortNameProp
+	}
+	descriptionProp, err := expandComputeFirewallPolicyDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	parentProp, err := expandComputeFirewallPolicyParent(d.Get("parent"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parent"); !isEmptyValue(reflect.ValueOf(parentProp)) && (ok || !reflect.DeepEqual(v, parentProp)) {
+		obj["parent"] = parentProp
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies?parentId={{parent}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new FirewallPolicy: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating FirewallPolicy: %s", err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	// The policy name is a server-assigned numeric id, so it's only known
+	// from the operation's target.
+	if op.TargetLink == "" {
+		return fmt.Errorf("Error creating FirewallPolicy: operation has no target")
+	}
+	d.Set("name", GetResourceNameFromSelfLink(op.TargetLink))
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "locations/global/firewallPolicies/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := computeOrgOperationWaitTime(
+		config, op, d.Get("parent").(string), "Creating FirewallPolicy",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create FirewallPolicy: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating FirewallPolicy %q: %#v", d.Id(), res)
+
+	return resourceComputeFirewallPolicyRead(d, meta)
+}
+
+func resourceComputeFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeFirewallPolicy %q", d.Id()))
+	}
+
+	if err := d.Set("creation_timestamp", flattenComputeFirewallPolicyCreationTimestamp(res["creationTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("name", flattenComputeFirewallPolicyName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("firewall_policy_id", flattenComputeFirewallPolicyFirewallPolicyId(res["id"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("short_name", flattenComputeFirewallPolicyShortName(res["shortName"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("description", flattenComputeFirewallPolicyDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("parent", flattenComputeFirewallPolicyParent(res["parent"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("fingerprint", flattenComputeFirewallPolicyFingerprint(res["fingerprint"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("self_link_with_id", flattenComputeFirewallPolicySelfLinkWithId(res["selfLinkWithId"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("rule_tuple_count", flattenComputeFirewallPolicyRuleTupleCount(res["ruleTupleCount"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeFirewallPolicyDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	fingerprintProp, err := expandComputeFirewallPolicyFingerprint(d.Get("fingerprint"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fingerprint"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, fingerprintProp)) {
+		obj["fingerprint"] = fingerprintProp
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating FirewallPolicy %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating FirewallPolicy %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOrgOperationWaitTime(
+		config, op, d.Get("parent").(string), "Updating FirewallPolicy",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeFirewallPolicyRead(d, meta)
+}
+
+func resourceComputeFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting FirewallPolicy %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "FirewallPolicy")
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOrgOperationWaitTime(
+		config, op, d.Get("parent").(string), "Deleting FirewallPolicy",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting FirewallPolicy %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeFirewallPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"locations/global/firewallPolicies/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "locations/global/firewallPolicies/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeFirewallPolicyCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyFirewallPolicyId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyShortName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyParent(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyFingerprint(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicySelfLinkWithId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleTupleCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func expandComputeFirewallPolicyShortName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyParent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyFingerprint(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+// getComputeFirewallPolicyParent looks up the organization or folder that owns
+// the policy referenced by firewall_policy. Operations on a policy's rules and
+// associations are scoped to that parent rather than to a project.
+func getComputeFirewallPolicyParent(d TerraformResourceData, config *Config) (string, error) {
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}")
+	if err != nil {
+		return "", err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("Error reading FirewallPolicy %q: %s", d.Get("firewall_policy"), err)
+	}
+
+	parent, ok := res["parent"].(string)
+	if !ok || parent == "" {
+		return "", fmt.Errorf("Error reading FirewallPolicy %q: parent is unset", d.Get("firewall_policy"))
+	}
+	return parent, nil
+}
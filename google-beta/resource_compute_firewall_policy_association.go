@@ -0,0 +1,241 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeFirewallPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeFirewallPolicyAssociationCreate,
+		Read:   resourceComputeFirewallPolicyAssociationRead,
+		Delete: resourceComputeFirewallPolicyAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeFirewallPolicyAssociationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attachment_target": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"firewall_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"short_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeFirewallPolicyAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeFirewallPolicyAssociationName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	attachmentTargetProp, err := expandComputeFirewallPolicyAssociationAttachmentTarget(d.Get("attachment_target"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("attachment_target"); !isEmptyValue(reflect.ValueOf(attachmentTargetProp)) && (ok || !reflect.DeepEqual(v, attachmentTargetProp)) {
+		obj["attachmentTarget"] = attachmentTargetProp
+	}
+
+	lockName, err := replaceVars(d, config, "firewallPolicies/{{firewall_policy}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}/addAssociation")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new FirewallPolicyAssociation: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating FirewallPolicyAssociation: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "locations/global/firewallPolicies/{{firewall_policy}}/associations/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	parent, err := getComputeFirewallPolicyParent(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOrgOperationWaitTime(
+		config, op, parent, "Creating FirewallPolicyAssociation",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create FirewallPolicyAssociation: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating FirewallPolicyAssociation %q: %#v", d.Id(), res)
+
+	return resourceComputeFirewallPolicyAssociationRead(d, meta)
+}
+
+func resourceComputeFirewallPolicyAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}/getAssociation?name={{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeFirewallPolicyAssociation %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenComputeFirewallPolicyAssociationName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyAssociation: %s", err)
+	}
+	if err := d.Set("attachment_target", flattenComputeFirewallPolicyAssociationAttachmentTarget(res["attachmentTarget"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyAssociation: %s", err)
+	}
+	if err := d.Set("short_name", flattenComputeFirewallPolicyAssociationShortName(res["shortName"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyAssociation: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeFirewallPolicyAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	lockName, err := replaceVars(d, config, "firewallPolicies/{{firewall_policy}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}/removeAssociation?name={{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting FirewallPolicyAssociation %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "FirewallPolicyAssociation")
+	}
+
+	parent, err := getComputeFirewallPolicyParent(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOrgOperationWaitTime(
+		config, op, parent, "Deleting FirewallPolicyAssociation",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting FirewallPolicyAssociation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeFirewallPolicyAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"locations/global/firewallPolicies/(?P<firewall_policy>[^/]+)/associations/(?P<name>[^/]+)", "(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "locations/global/firewallPolicies/{{firewall_policy}}/associations/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeFirewallPolicyAssociationName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyAssociationAttachmentTarget(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyAssociationShortName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandComputeFirewallPolicyAssociationName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyAssociationAttachmentTarget(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
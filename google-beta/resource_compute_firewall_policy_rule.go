@@ -0,0 +1,684 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeFirewallPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeFirewallPolicyRuleCreate,
+		Read:   resourceComputeFirewallPolicyRuleRead,
+		Update: resourceComputeFirewallPolicyRuleUpdate,
+		Delete: resourceComputeFirewallPolicyRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeFirewallPolicyRuleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny", "goto_next"}, false),
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INGRESS", "EGRESS"}, false),
+			},
+			"firewall_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"match": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"layer4_configs": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_protocol": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"ports": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"dest_ip_ranges": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"src_ip_ranges": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"enable_logging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"target_resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"target_service_accounts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_tuple_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeFirewallPolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeFirewallPolicyRuleDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	priorityProp, err := expandComputeFirewallPolicyRulePriority(d.Get("priority"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("priority"); ok || !reflect.DeepEqual(v, priorityProp) {
+		obj["priority"] = priorityProp
+	}
+	matchProp, err := expandComputeFirewallPolicyRuleMatch(d.Get("match"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("match"); !isEmptyValue(reflect.ValueOf(matchProp)) && (ok || !reflect.DeepEqual(v, matchProp)) {
+		obj["match"] = matchProp
+	}
+	actionProp, err := expandComputeFirewallPolicyRuleAction(d.Get("action"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("action"); !isEmptyValue(reflect.ValueOf(actionProp)) && (ok || !reflect.DeepEqual(v, actionProp)) {
+		obj["action"] = actionProp
+	}
+	directionProp, err := expandComputeFirewallPolicyRuleDirection(d.Get("direction"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("direction"); !isEmptyValue(reflect.ValueOf(directionProp)) && (ok || !reflect.DeepEqual(v, directionProp)) {
+		obj["direction"] = directionProp
+	}
+	targetResourcesProp, err := expandComputeFirewallPolicyRuleTargetResources(d.Get("target_resources"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("target_resources"); !isEmptyValue(reflect.ValueOf(targetResourcesProp)) && (ok || !reflect.DeepEqual(v, targetResourcesProp)) {
+		obj["targetResources"] = targetResourcesProp
+	}
+	enableLoggingProp, err := expandComputeFirewallPolicyRuleEnableLogging(d.Get("enable_logging"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("enable_logging"); ok || !reflect.DeepEqual(v, enableLoggingProp) {
+		obj["enableLogging"] = enableLoggingProp
+	}
+	targetServiceAccountsProp, err := expandComputeFirewallPolicyRuleTargetServiceAccounts(d.Get("target_service_accounts"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("target_service_accounts"); !isEmptyValue(reflect.ValueOf(targetServiceAccountsProp)) && (ok || !reflect.DeepEqual(v, targetServiceAccountsProp)) {
+		obj["targetServiceAccounts"] = targetServiceAccountsProp
+	}
+	disabledProp, err := expandComputeFirewallPolicyRuleDisabled(d.Get("disabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("disabled"); ok || !reflect.DeepEqual(v, disabledProp) {
+		obj["disabled"] = disabledProp
+	}
+
+	lockName, err := replaceVars(d, config, "firewallPolicies/{{firewall_policy}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}/addRule")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new FirewallPolicyRule: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating FirewallPolicyRule: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "locations/global/firewallPolicies/{{firewall_policy}}/rules/{{priority}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	parent, err := getComputeFirewallPolicyParent(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOrgOperationWaitTime(
+		config, op, parent, "Creating FirewallPolicyRule",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create FirewallPolicyRule: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating FirewallPolicyRule %q: %#v", d.Id(), res)
+
+	return resourceComputeFirewallPolicyRuleRead(d, meta)
+}
+
+func resourceComputeFirewallPolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}/getRule?priority={{priority}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeFirewallPolicyRule %q", d.Id()))
+	}
+
+	if err := d.Set("description", flattenComputeFirewallPolicyRuleDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("priority", flattenComputeFirewallPolicyRulePriority(res["priority"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("match", flattenComputeFirewallPolicyRuleMatch(res["match"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("action", flattenComputeFirewallPolicyRuleAction(res["action"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("direction", flattenComputeFirewallPolicyRuleDirection(res["direction"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("target_resources", flattenComputeFirewallPolicyRuleTargetResources(res["targetResources"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("enable_logging", flattenComputeFirewallPolicyRuleEnableLogging(res["enableLogging"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("rule_tuple_count", flattenComputeFirewallPolicyRuleRuleTupleCount(res["ruleTupleCount"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("target_service_accounts", flattenComputeFirewallPolicyRuleTargetServiceAccounts(res["targetServiceAccounts"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("disabled", flattenComputeFirewallPolicyRuleDisabled(res["disabled"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+	if err := d.Set("kind", flattenComputeFirewallPolicyRuleKind(res["kind"], d)); err != nil {
+		return fmt.Errorf("Error reading FirewallPolicyRule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeFirewallPolicyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeFirewallPolicyRuleDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	priorityProp, err := expandComputeFirewallPolicyRulePriority(d.Get("priority"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("priority"); ok || !reflect.DeepEqual(v, priorityProp) {
+		obj["priority"] = priorityProp
+	}
+	matchProp, err := expandComputeFirewallPolicyRuleMatch(d.Get("match"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("match"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, matchProp)) {
+		obj["match"] = matchProp
+	}
+	actionProp, err := expandComputeFirewallPolicyRuleAction(d.Get("action"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("action"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, actionProp)) {
+		obj["action"] = actionProp
+	}
+	directionProp, err := expandComputeFirewallPolicyRuleDirection(d.Get("direction"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("direction"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, directionProp)) {
+		obj["direction"] = directionProp
+	}
+	targetResourcesProp, err := expandComputeFirewallPolicyRuleTargetResources(d.Get("target_resources"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("target_resources"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, targetResourcesProp)) {
+		obj["targetResources"] = targetResourcesProp
+	}
+	enableLoggingProp, err := expandComputeFirewallPolicyRuleEnableLogging(d.Get("enable_logging"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("enable_logging"); ok || !reflect.DeepEqual(v, enableLoggingProp) {
+		obj["enableLogging"] = enableLoggingProp
+	}
+	targetServiceAccountsProp, err := expandComputeFirewallPolicyRuleTargetServiceAccounts(d.Get("target_service_accounts"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("target_service_accounts"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, targetServiceAccountsProp)) {
+		obj["targetServiceAccounts"] = targetServiceAccountsProp
+	}
+	disabledProp, err := expandComputeFirewallPolicyRuleDisabled(d.Get("disabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("disabled"); ok || !reflect.DeepEqual(v, disabledProp) {
+		obj["disabled"] = disabledProp
+	}
+
+	lockName, err := replaceVars(d, config, "firewallPolicies/{{firewall_policy}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}/patchRule?priority={{priority}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating FirewallPolicyRule %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating FirewallPolicyRule %q: %s", d.Id(), err)
+	}
+
+	parent, err := getComputeFirewallPolicyParent(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOrgOperationWaitTime(
+		config, op, parent, "Updating FirewallPolicyRule",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeFirewallPolicyRuleRead(d, meta)
+}
+
+func resourceComputeFirewallPolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	lockName, err := replaceVars(d, config, "firewallPolicies/{{firewall_policy}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{firewall_policy}}/removeRule?priority={{priority}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting FirewallPolicyRule %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "FirewallPolicyRule")
+	}
+
+	parent, err := getComputeFirewallPolicyParent(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOrgOperationWaitTime(
+		config, op, parent, "Deleting FirewallPolicyRule",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting FirewallPolicyRule %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeFirewallPolicyRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"locations/global/firewallPolicies/(?P<firewall_policy>[^/]+)/rules/(?P<priority>[^/]+)", "(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "locations/global/firewallPolicies/{{firewall_policy}}/rules/{{priority}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeFirewallPolicyRuleDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRulePriority(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleMatch(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["src_ip_ranges"] =
+		flattenComputeFirewallPolicyRuleMatchSrcIpRanges(original["srcIpRanges"], d)
+	transformed["dest_ip_ranges"] =
+		flattenComputeFirewallPolicyRuleMatchDestIpRanges(original["destIpRanges"], d)
+	transformed["layer4_configs"] =
+		flattenComputeFirewallPolicyRuleMatchLayer4Configs(original["layer4Configs"], d)
+	return []interface{}{transformed}
+}
+func flattenComputeFirewallPolicyRuleMatchSrcIpRanges(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleMatchDestIpRanges(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleMatchLayer4Configs(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"ip_protocol": flattenComputeFirewallPolicyRuleMatchLayer4ConfigsIpProtocol(original["ipProtocol"], d),
+			"ports":       flattenComputeFirewallPolicyRuleMatchLayer4ConfigsPorts(original["ports"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeFirewallPolicyRuleMatchLayer4ConfigsIpProtocol(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleMatchLayer4ConfigsPorts(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleAction(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleDirection(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleTargetResources(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleEnableLogging(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleRuleTupleCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleTargetServiceAccounts(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleDisabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFirewallPolicyRuleKind(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandComputeFirewallPolicyRuleDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRulePriority(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleMatch(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSrcIpRanges, err := expandComputeFirewallPolicyRuleMatchSrcIpRanges(original["src_ip_ranges"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSrcIpRanges); val.IsValid() && !isEmptyValue(val) {
+		transformed["srcIpRanges"] = transformedSrcIpRanges
+	}
+
+	transformedDestIpRanges, err := expandComputeFirewallPolicyRuleMatchDestIpRanges(original["dest_ip_ranges"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDestIpRanges); val.IsValid() && !isEmptyValue(val) {
+		transformed["destIpRanges"] = transformedDestIpRanges
+	}
+
+	transformedLayer4Configs, err := expandComputeFirewallPolicyRuleMatchLayer4Configs(original["layer4_configs"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLayer4Configs); val.IsValid() && !isEmptyValue(val) {
+		transformed["layer4Configs"] = transformedLayer4Configs
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFirewallPolicyRuleMatchSrcIpRanges(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleMatchDestIpRanges(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleMatchLayer4Configs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedIpProtocol, err := expandComputeFirewallPolicyRuleMatchLayer4ConfigsIpProtocol(original["ip_protocol"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedIpProtocol); val.IsValid() && !isEmptyValue(val) {
+			transformed["ipProtocol"] = transformedIpProtocol
+		}
+
+		transformedPorts, err := expandComputeFirewallPolicyRuleMatchLayer4ConfigsPorts(original["ports"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPorts); val.IsValid() && !isEmptyValue(val) {
+			transformed["ports"] = transformedPorts
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeFirewallPolicyRuleMatchLayer4ConfigsIpProtocol(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleMatchLayer4ConfigsPorts(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleAction(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleDirection(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleTargetResources(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleEnableLogging(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleTargetServiceAccounts(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFirewallPolicyRuleDisabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,221 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeFirewallPolicy_update(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	policyName := fmt.Sprintf("tf-test-policy-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFirewallPolicy_basic(org, policyName, "Org level firewall policy"),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeFirewallPolicy_basic(org, policyName, "An updated description"),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeFirewallPolicyRule_update(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFirewallPolicyRule_basic(org, suffix),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_rule.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeFirewallPolicyRule_update(org, suffix),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_rule.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeFirewallPolicyRule_basic(org, suffix),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_rule.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeFirewallPolicyAssociation_basic(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFirewallPolicyAssociation_basic(org, suffix),
+			},
+			{
+				ResourceName:      "google_compute_firewall_policy_association.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeFirewallPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_firewall_policy" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/locations/global/firewallPolicies/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("FirewallPolicy still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeFirewallPolicy_basic(org, policyName, description string) string {
+	return fmt.Sprintf(`
+resource "google_compute_firewall_policy" "default" {
+  parent      = "organizations/%s"
+  short_name  = "%s"
+  description = "%s"
+}
+`, org, policyName, description)
+}
+
+func testAccComputeFirewallPolicyRule_basic(org, suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_firewall_policy" "default" {
+  parent      = "organizations/%s"
+  short_name  = "tf-test-policy-%s"
+  description = "Resource created for Terraform acceptance testing"
+}
+
+resource "google_compute_firewall_policy_rule" "default" {
+  firewall_policy = "${google_compute_firewall_policy.default.name}"
+  description     = "Resource created for Terraform acceptance testing"
+  priority        = 9000
+  enable_logging  = true
+  action          = "allow"
+  direction       = "EGRESS"
+  disabled        = false
+
+  match {
+    layer4_configs {
+      ip_protocol = "tcp"
+      ports       = ["80", "8080"]
+    }
+    dest_ip_ranges = ["11.100.0.1/32"]
+  }
+}
+`, org, suffix)
+}
+
+func testAccComputeFirewallPolicyRule_update(org, suffix string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "service_account" {
+  account_id = "tf-test-sa-%s"
+}
+
+resource "google_compute_firewall_policy" "default" {
+  parent      = "organizations/%s"
+  short_name  = "tf-test-policy-%s"
+  description = "Resource created for Terraform acceptance testing"
+}
+
+resource "google_compute_firewall_policy_rule" "default" {
+  firewall_policy         = "${google_compute_firewall_policy.default.name}"
+  description             = "Resource created for Terraform acceptance testing"
+  priority                = 9000
+  enable_logging          = false
+  action                  = "goto_next"
+  direction               = "INGRESS"
+  disabled                = true
+  target_service_accounts = ["${google_service_account.service_account.email}"]
+
+  match {
+    layer4_configs {
+      ip_protocol = "tcp"
+      ports       = ["8080"]
+    }
+    layer4_configs {
+      ip_protocol = "udp"
+      ports       = ["22"]
+    }
+    src_ip_ranges = ["11.100.0.1/32", "10.0.0.0/24"]
+  }
+}
+`, suffix, org, suffix)
+}
+
+func testAccComputeFirewallPolicyAssociation_basic(org, suffix string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "folder" {
+  display_name = "tf-test-folder-%s"
+  parent       = "organizations/%s"
+}
+
+resource "google_compute_firewall_policy" "default" {
+  parent      = "organizations/%s"
+  short_name  = "tf-test-policy-%s"
+  description = "Resource created for Terraform acceptance testing"
+}
+
+resource "google_compute_firewall_policy_association" "default" {
+  firewall_policy   = "${google_compute_firewall_policy.default.name}"
+  attachment_target = "${google_folder.folder.name}"
+  name              = "tf-test-association-%s"
+}
+`, suffix, org, org, suffix, suffix)
+}
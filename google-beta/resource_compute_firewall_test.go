@@ -296,6 +296,49 @@ func TestAccComputeFirewall_enableLogging(t *testing.T) {
 	})
 }
 
+func TestAccComputeFirewall_logConfig(t *testing.T) {
+	t.Parallel()
+
+	var firewall computeBeta.Firewall
+	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFirewall_logConfig(networkName, firewallName, "EXCLUDE_ALL_METADATA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeBetaFirewallExists("google_compute_firewall.foobar", &firewall),
+					testAccCheckComputeFirewallLoggingEnabled(&firewall, true),
+				),
+			},
+			{
+				ResourceName:      "google_compute_firewall.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeFirewall_logConfig(networkName, firewallName, "INCLUDE_ALL_METADATA"),
+			},
+			{
+				ResourceName:      "google_compute_firewall.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeFirewall_enableLogging(networkName, firewallName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeBetaFirewallExists("google_compute_firewall.foobar", &firewall),
+					testAccCheckComputeFirewallLoggingEnabled(&firewall, false),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeFirewallExists(n string, firewall *compute.Firewall) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -429,8 +472,12 @@ func testAccCheckComputeFirewallApiVersion(firewall *compute.Firewall) resource.
 
 func testAccCheckComputeFirewallLoggingEnabled(firewall *computeBeta.Firewall, enabled bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if firewall == nil || firewall.EnableLogging != enabled {
-			return fmt.Errorf("expected firewall enable_logging to be %t, got %t", enabled, firewall.EnableLogging)
+		if firewall == nil {
+			return fmt.Errorf("expected a firewall, got nil")
+		}
+		logging := firewall.LogConfig != nil && firewall.LogConfig.Enable
+		if logging != enabled {
+			return fmt.Errorf("expected firewall logConfig.enable to be %t, got %t", enabled, logging)
 		}
 		return nil
 	}
@@ -636,3 +683,27 @@ func testAccComputeFirewall_enableLogging(network, firewall string, enableLoggin
 		%s
 	}`, network, firewall, enableLoggingCfg)
 }
+
+func testAccComputeFirewall_logConfig(network, firewall, metadata string) string {
+	return fmt.Sprintf(`
+	resource "google_compute_network" "foobar" {
+		name = "%s"
+		auto_create_subnetworks = false
+		ipv4_range = "10.0.0.0/16"
+	}
+
+	resource "google_compute_firewall" "foobar" {
+		name = "firewall-test-%s"
+		description = "Resource created for Terraform acceptance testing"
+		network = "${google_compute_network.foobar.name}"
+		source_tags = ["foo"]
+
+		allow {
+			protocol = "icmp"
+		}
+
+		log_config {
+			metadata = "%s"
+		}
+	}`, network, firewall, metadata)
+}
@@ -87,6 +87,28 @@ func TestAccComputeForwardingRule_networkTier(t *testing.T) {
 	})
 }
 
+func TestAccComputeForwardingRule_internalManaged(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeForwardingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeForwardingRule_internalManaged(suffix),
+			},
+			{
+				ResourceName:      "google_compute_forwarding_rule.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccComputeForwardingRule_basic(poolName, ruleName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_target_pool" "foo-tp" {
@@ -167,3 +189,69 @@ resource "google_compute_forwarding_rule" "foobar" {
 }
 `, poolName, ruleName)
 }
+
+func testAccComputeForwardingRule_internalManaged(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_forwarding_rule" "foobar" {
+  name                  = "tf-test-rule-%s"
+  region                = "us-central1"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  port_range            = "80"
+  target                = "${google_compute_region_target_http_proxy.foobar.self_link}"
+  network               = "${google_compute_network.foobar.self_link}"
+  subnetwork            = "${google_compute_subnetwork.foobar.self_link}"
+
+  depends_on = ["google_compute_subnetwork.proxy"]
+}
+
+resource "google_compute_region_target_http_proxy" "foobar" {
+  name    = "tf-test-proxy-%s"
+  region  = "us-central1"
+  url_map = "${google_compute_region_url_map.foobar.self_link}"
+}
+
+resource "google_compute_region_url_map" "foobar" {
+  name            = "tf-test-url-map-%s"
+  region          = "us-central1"
+  default_service = "${google_compute_region_backend_service.foobar.self_link}"
+}
+
+resource "google_compute_region_backend_service" "foobar" {
+  name                  = "tf-test-backend-%s"
+  region                = "us-central1"
+  protocol              = "HTTP"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  health_checks         = ["${google_compute_region_health_check.foobar.self_link}"]
+}
+
+resource "google_compute_region_health_check" "foobar" {
+  name   = "tf-test-hc-%s"
+  region = "us-central1"
+
+  http_health_check {
+    port = 80
+  }
+}
+
+resource "google_compute_network" "foobar" {
+  name                    = "tf-test-net-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+  name          = "tf-test-subnet-%s"
+  ip_cidr_range = "10.0.1.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.foobar.self_link}"
+}
+
+resource "google_compute_subnetwork" "proxy" {
+  name          = "tf-test-proxy-subnet-%s"
+  ip_cidr_range = "10.0.2.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.foobar.self_link}"
+  purpose       = "INTERNAL_HTTPS_LOAD_BALANCER"
+  role          = "ACTIVE"
+}
+`, suffix, suffix, suffix, suffix, suffix, suffix, suffix, suffix)
+}
@@ -0,0 +1,412 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeGlobalNetworkEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeGlobalNetworkEndpointCreate,
+		Read:   resourceComputeGlobalNetworkEndpointRead,
+		Delete: resourceComputeGlobalNetworkEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeGlobalNetworkEndpointImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_network_endpoint_group": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeGlobalNetworkEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	portProp, err := expandComputeGlobalNetworkEndpointPort(d.Get("port"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("port"); !isEmptyValue(reflect.ValueOf(portProp)) && (ok || !reflect.DeepEqual(v, portProp)) {
+		obj["port"] = portProp
+	}
+	ipAddressProp, err := expandComputeGlobalNetworkEndpointIpAddress(d.Get("ip_address"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ip_address"); !isEmptyValue(reflect.ValueOf(ipAddressProp)) && (ok || !reflect.DeepEqual(v, ipAddressProp)) {
+		obj["ipAddress"] = ipAddressProp
+	}
+	fqdnProp, err := expandComputeGlobalNetworkEndpointFqdn(d.Get("fqdn"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fqdn"); !isEmptyValue(reflect.ValueOf(fqdnProp)) && (ok || !reflect.DeepEqual(v, fqdnProp)) {
+		obj["fqdn"] = fqdnProp
+	}
+
+	obj, err = resourceComputeGlobalNetworkEndpointEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	lockName, err := replaceVars(d, config, "networkEndpoint/{{project}}/{{global_network_endpoint_group}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/networkEndpointGroups/{{global_network_endpoint_group}}/attachNetworkEndpoints")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new GlobalNetworkEndpoint: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating GlobalNetworkEndpoint: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{project}}/{{global_network_endpoint_group}}/{{ip_address}}/{{fqdn}}/{{port}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config.clientCompute, op, project, "Creating GlobalNetworkEndpoint",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create GlobalNetworkEndpoint: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating GlobalNetworkEndpoint %q: %#v", d.Id(), res)
+
+	return resourceComputeGlobalNetworkEndpointRead(d, meta)
+}
+
+func resourceComputeGlobalNetworkEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/networkEndpointGroups/{{global_network_endpoint_group}}/listNetworkEndpoints")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "POST", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeGlobalNetworkEndpoint %q", d.Id()))
+	}
+
+	res, err = flattenNestedComputeGlobalNetworkEndpoint(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	if res == nil {
+		// Object isn't there any more - remove it from the state.
+		log.Printf("[DEBUG] Removing ComputeGlobalNetworkEndpoint because it couldn't be matched.")
+		d.SetId("")
+		return nil
+	}
+
+	res, err = resourceComputeGlobalNetworkEndpointDecoder(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading GlobalNetworkEndpoint: %s", err)
+	}
+
+	if err := d.Set("port", flattenComputeGlobalNetworkEndpointPort(res["port"], d)); err != nil {
+		return fmt.Errorf("Error reading GlobalNetworkEndpoint: %s", err)
+	}
+	if err := d.Set("ip_address", flattenComputeGlobalNetworkEndpointIpAddress(res["ipAddress"], d)); err != nil {
+		return fmt.Errorf("Error reading GlobalNetworkEndpoint: %s", err)
+	}
+	if err := d.Set("fqdn", flattenComputeGlobalNetworkEndpointFqdn(res["fqdn"], d)); err != nil {
+		return fmt.Errorf("Error reading GlobalNetworkEndpoint: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeGlobalNetworkEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	lockName, err := replaceVars(d, config, "networkEndpoint/{{project}}/{{global_network_endpoint_group}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/networkEndpointGroups/{{global_network_endpoint_group}}/detachNetworkEndpoints")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	toDelete := make(map[string]interface{})
+	portProp, err := expandComputeGlobalNetworkEndpointPort(d.Get("port"), d, config)
+	if err != nil {
+		return err
+	}
+	if portProp != 0 {
+		toDelete["port"] = portProp
+	}
+
+	ipAddressProp, err := expandComputeGlobalNetworkEndpointIpAddress(d.Get("ip_address"), d, config)
+	if err != nil {
+		return err
+	}
+	if ipAddressProp != "" {
+		toDelete["ipAddress"] = ipAddressProp
+	}
+
+	fqdnProp, err := expandComputeGlobalNetworkEndpointFqdn(d.Get("fqdn"), d, config)
+	if err != nil {
+		return err
+	}
+	if fqdnProp != "" {
+		toDelete["fqdn"] = fqdnProp
+	}
+
+	obj = map[string]interface{}{
+		"networkEndpoints": []map[string]interface{}{toDelete},
+	}
+	log.Printf("[DEBUG] Deleting GlobalNetworkEndpoint %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "GlobalNetworkEndpoint")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Deleting GlobalNetworkEndpoint",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting GlobalNetworkEndpoint %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeGlobalNetworkEndpointImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<project>[^/]+)/(?P<global_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]+)", "(?P<global_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{project}}/{{global_network_endpoint_group}}/{{ip_address}}/{{fqdn}}/{{port}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeGlobalNetworkEndpointPort(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles int given in float64 format
+	if floatVal, ok := v.(float64); ok {
+		return int(floatVal)
+	}
+	return v
+}
+
+func flattenComputeGlobalNetworkEndpointIpAddress(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeGlobalNetworkEndpointFqdn(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandComputeGlobalNetworkEndpointPort(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeGlobalNetworkEndpointIpAddress(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeGlobalNetworkEndpointFqdn(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func resourceComputeGlobalNetworkEndpointEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	wrappedReq := map[string]interface{}{
+		"networkEndpoints": []interface{}{obj},
+	}
+	return wrappedReq, nil
+}
+
+func flattenNestedComputeGlobalNetworkEndpoint(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	var v interface{}
+	var ok bool
+
+	v, ok = res["items"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	switch v.(type) {
+	case []interface{}:
+		break
+	case map[string]interface{}:
+		// Construct list out of single nested resource
+		v = []interface{}{v}
+	default:
+		return nil, fmt.Errorf("expected list or map for value items. Actual value: %v", v)
+	}
+
+	expectedPort, err := expandComputeGlobalNetworkEndpointPort(d.Get("port"), d, meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	expectedIpAddress, err := expandComputeGlobalNetworkEndpointIpAddress(d.Get("ip_address"), d, meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	expectedFqdn, err := expandComputeGlobalNetworkEndpointFqdn(d.Get("fqdn"), d, meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+
+	// Search list for this resource.
+	items := v.([]interface{})
+	for _, itemRaw := range items {
+		if itemRaw == nil {
+			continue
+		}
+		item := itemRaw.(map[string]interface{})
+
+		// Decode list item before comparing.
+		item, err := resourceComputeGlobalNetworkEndpointDecoder(d, meta, item)
+		if err != nil {
+			return nil, err
+		}
+
+		itemPort := flattenComputeGlobalNetworkEndpointPort(item["port"], d)
+		if !reflect.DeepEqual(itemPort, expectedPort) {
+			log.Printf("[DEBUG] Skipping item with port= %#v, looking for %#v)", itemPort, expectedPort)
+			continue
+		}
+		// ipAddress and fqdn are omitted from the API response when unset.
+		itemIpAddress := flattenComputeGlobalNetworkEndpointIpAddress(item["ipAddress"], d)
+		if itemIpAddress == nil {
+			itemIpAddress = ""
+		}
+		if !reflect.DeepEqual(itemIpAddress, expectedIpAddress) {
+			log.Printf("[DEBUG] Skipping item with ipAddress= %#v, looking for %#v)", itemIpAddress, expectedIpAddress)
+			continue
+		}
+		itemFqdn := flattenComputeGlobalNetworkEndpointFqdn(item["fqdn"], d)
+		if itemFqdn == nil {
+			itemFqdn = ""
+		}
+		if !reflect.DeepEqual(itemFqdn, expectedFqdn) {
+			log.Printf("[DEBUG] Skipping item with fqdn= %#v, looking for %#v)", itemFqdn, expectedFqdn)
+			continue
+		}
+		log.Printf("[DEBUG] Found item for resource %q: %#v)", d.Id(), item)
+		return item, nil
+	}
+
+	return nil, nil
+}
+
+func resourceComputeGlobalNetworkEndpointDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	v, ok := res["networkEndpoint"]
+	if !ok || v == nil {
+		return res, nil
+	}
+
+	return v.(map[string]interface{}), nil
+}
@@ -0,0 +1,163 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeGlobalNetworkEndpoint_networkEndpointsBasic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+		"default_port":  90,
+		"modified_port": 100,
+	}
+	negId := fmt.Sprintf("projects/%s/global/networkEndpointGroups/neg-%s",
+		getTestProjectFromEnv(), context["random_suffix"])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Create one endpoint
+				Config: testAccComputeGlobalNetworkEndpoint_networkEndpointsBasic(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeGlobalNetworkEndpointWithPortExists("google_compute_global_network_endpoint.default", "90"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_global_network_endpoint.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Force-recreate old endpoint
+				Config: testAccComputeGlobalNetworkEndpoint_networkEndpointsModified(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeGlobalNetworkEndpointWithPortExists("google_compute_global_network_endpoint.default", "100"),
+					testAccCheckComputeNetworkEndpointWithPortsDestroyed(negId, "90"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_global_network_endpoint.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// delete all endpoints
+				Config: testAccComputeGlobalNetworkEndpoint_noNetworkEndpoints(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNetworkEndpointWithPortsDestroyed(negId, "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeGlobalNetworkEndpoint_networkEndpointsBasic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_global_network_endpoint" "default" {
+  global_network_endpoint_group = "${google_compute_global_network_endpoint_group.neg.name}"
+  ip_address                    = "8.8.8.8"
+  port                          = "${google_compute_global_network_endpoint_group.neg.default_port}"
+}
+`, context) + testAccComputeGlobalNetworkEndpoint_noNetworkEndpoints(context)
+}
+
+func testAccComputeGlobalNetworkEndpoint_networkEndpointsModified(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_global_network_endpoint" "default" {
+  global_network_endpoint_group = "${google_compute_global_network_endpoint_group.neg.name}"
+  ip_address                    = "8.8.8.8"
+  port                          = "%{modified_port}"
+}
+`, context) + testAccComputeGlobalNetworkEndpoint_noNetworkEndpoints(context)
+}
+
+func testAccComputeGlobalNetworkEndpoint_noNetworkEndpoints(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_global_network_endpoint_group" "neg" {
+  name                  = "neg-%{random_suffix}"
+  default_port          = "%{default_port}"
+  network_endpoint_type = "INTERNET_IP_PORT"
+}
+`, context)
+}
+
+func TestAccComputeGlobalNetworkEndpoint_fqdnEndpoint(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeGlobalNetworkEndpoint_fqdnEndpoint(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeGlobalNetworkEndpointWithPortExists("google_compute_global_network_endpoint.default", "443"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_global_network_endpoint.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeGlobalNetworkEndpoint_fqdnEndpoint(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_global_network_endpoint" "default" {
+  global_network_endpoint_group = "${google_compute_global_network_endpoint_group.neg.name}"
+  fqdn                          = "www.example.com"
+  port                          = 443
+}
+
+resource "google_compute_global_network_endpoint_group" "neg" {
+  name                  = "neg-%{random_suffix}"
+  network_endpoint_type = "INTERNET_FQDN_PORT"
+}
+`, context)
+}
+
+func testAccCheckComputeGlobalNetworkEndpointWithPortExists(name, port string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %q not in path %q", name, s.RootModule().Path)
+		}
+
+		if rs.Type != "google_compute_global_network_endpoint" {
+			return fmt.Errorf("resource %q has unexpected type %q", name, rs.Type)
+		}
+
+		if rs.Primary.Attributes["port"] != port {
+			return fmt.Errorf("unexpected port %s for resource %s, expected %s", rs.Primary.Attributes["port"], name, port)
+		}
+
+		negResourceId, err := replaceVarsForTest(rs, "projects/{{project}}/global/networkEndpointGroups/{{global_network_endpoint_group}}")
+		if err != nil {
+			return fmt.Errorf("creating URL for getting network endpoint %q failed: %v", name, err)
+		}
+
+		foundPorts, err := testAccComputeNetworkEndpointsListEndpointPorts(negResourceId)
+		if err != nil {
+			return fmt.Errorf("unable to confirm endpoints with port %s exists: %v", port, err)
+		}
+		if _, ok := foundPorts[port]; !ok {
+			return fmt.Errorf("did not find endpoint with port %s", port)
+		}
+		return nil
+	}
+}
@@ -168,6 +168,96 @@ func TestAccComputeHealthCheck_https(t *testing.T) {
 	})
 }
 
+func TestAccComputeHealthCheck_http2(t *testing.T) {
+	t.Parallel()
+
+	var healthCheck compute.HealthCheck
+
+	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeHealthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeHealthCheck_http2(hckName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeHealthCheckExists(
+						"google_compute_health_check.foobar", &healthCheck),
+					testAccCheckComputeHealthCheckThresholds(
+						3, 3, &healthCheck),
+				),
+			},
+			{
+				ResourceName:      "google_compute_health_check.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeHealthCheck_grpc(t *testing.T) {
+	t.Parallel()
+
+	var healthCheck compute.HealthCheck
+
+	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeHealthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeHealthCheck_grpc(hckName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeHealthCheckExists(
+						"google_compute_health_check.foobar", &healthCheck),
+					testAccCheckComputeHealthCheckThresholds(
+						3, 3, &healthCheck),
+				),
+			},
+			{
+				ResourceName:      "google_compute_health_check.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeHealthCheck_logConfig(t *testing.T) {
+	t.Parallel()
+
+	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeHealthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeHealthCheck_logConfig(hckName, true),
+			},
+			{
+				ResourceName:      "google_compute_health_check.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeHealthCheck_logConfig(hckName, false),
+			},
+			{
+				ResourceName:      "google_compute_health_check.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeHealthCheck_typeTransition(t *testing.T) {
 	t.Parallel()
 
@@ -190,6 +280,12 @@ func TestAccComputeHealthCheck_typeTransition(t *testing.T) {
 			{
 				Config: testAccComputeHealthCheck_tcp(hckName),
 			},
+			{
+				Config: testAccComputeHealthCheck_http2(hckName),
+			},
+			{
+				Config: testAccComputeHealthCheck_grpc(hckName),
+			},
 			{
 				Config: testAccComputeHealthCheck_https(hckName),
 			},
@@ -346,6 +442,53 @@ resource "google_compute_health_check" "foobar" {
 `, hckName)
 }
 
+func testAccComputeHealthCheck_http2(hckName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_health_check" "foobar" {
+	check_interval_sec = 3
+	description = "Resource created for Terraform acceptance testing"
+	healthy_threshold = 3
+	name = "health-test-%s"
+	timeout_sec = 2
+	unhealthy_threshold = 3
+	http2_health_check {
+		port = "443"
+	}
+}
+`, hckName)
+}
+
+func testAccComputeHealthCheck_grpc(hckName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_health_check" "foobar" {
+	check_interval_sec = 3
+	description = "Resource created for Terraform acceptance testing"
+	healthy_threshold = 3
+	name = "health-test-%s"
+	timeout_sec = 2
+	unhealthy_threshold = 3
+	grpc_health_check {
+		port_specification = "USE_SERVING_PORT"
+		grpc_service_name = "testservice"
+	}
+}
+`, hckName)
+}
+
+func testAccComputeHealthCheck_logConfig(hckName string, enable bool) string {
+	return fmt.Sprintf(`
+resource "google_compute_health_check" "foobar" {
+	name = "health-test-%s"
+	http_health_check {
+		port = "80"
+	}
+	log_config {
+		enable = %t
+	}
+}
+`, hckName, enable)
+}
+
 func testAccComputeHealthCheck_tcpAndSsl_shouldFail(hckName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_health_check" "foobar" {
@@ -65,6 +65,22 @@ func resourceComputeImage() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"guest_os_features": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"MULTI_IP_SUBNET", "SECURE_BOOT", "UEFI_COMPATIBLE", "VIRTIO_SCSI_MULTIQUEUE", "WINDOWS"}, false),
+						},
+					},
+				},
+			},
 			"labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -113,6 +129,58 @@ func resourceComputeImage() *schema.Resource {
 				ForceNew:         true,
 				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
+			"source_image": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"source_snapshot": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"storage_locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deprecated": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"DEPRECATED", "OBSOLETE", "DELETED"}, false),
+						},
+						"deleted": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"deprecated": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"obsolete": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"replacement": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+					},
+				},
+			},
 			"archive_size_bytes": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -161,6 +229,12 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	} else if v, ok := d.GetOkExists("family"); !isEmptyValue(reflect.ValueOf(familyProp)) && (ok || !reflect.DeepEqual(v, familyProp)) {
 		obj["family"] = familyProp
 	}
+	guestOsFeaturesProp, err := expandComputeImageGuestOsFeatures(d.Get("guest_os_features"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("guest_os_features"); !isEmptyValue(reflect.ValueOf(guestOsFeaturesProp)) && (ok || !reflect.DeepEqual(v, guestOsFeaturesProp)) {
+		obj["guestOsFeatures"] = guestOsFeaturesProp
+	}
 	labelsProp, err := expandComputeImageLabels(d.Get("labels"), d, config)
 	if err != nil {
 		return err
@@ -197,6 +271,24 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	} else if v, ok := d.GetOkExists("source_disk"); !isEmptyValue(reflect.ValueOf(sourceDiskProp)) && (ok || !reflect.DeepEqual(v, sourceDiskProp)) {
 		obj["sourceDisk"] = sourceDiskProp
 	}
+	sourceImageProp, err := expandComputeImageSourceImage(d.Get("source_image"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("source_image"); !isEmptyValue(reflect.ValueOf(sourceImageProp)) && (ok || !reflect.DeepEqual(v, sourceImageProp)) {
+		obj["sourceImage"] = sourceImageProp
+	}
+	sourceSnapshotProp, err := expandComputeImageSourceSnapshot(d.Get("source_snapshot"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("source_snapshot"); !isEmptyValue(reflect.ValueOf(sourceSnapshotProp)) && (ok || !reflect.DeepEqual(v, sourceSnapshotProp)) {
+		obj["sourceSnapshot"] = sourceSnapshotProp
+	}
+	storageLocationsProp, err := expandComputeImageStorageLocations(d.Get("storage_locations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("storage_locations"); !isEmptyValue(reflect.ValueOf(storageLocationsProp)) && (ok || !reflect.DeepEqual(v, storageLocationsProp)) {
+		obj["storageLocations"] = storageLocationsProp
+	}
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/images")
 	if err != nil {
@@ -236,6 +328,12 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error waiting to create Image: %s", waitErr)
 	}
 
+	if _, ok := d.GetOk("deprecated"); ok {
+		if err := resourceComputeImageSetDeprecated(d, config, project, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Finished creating Image %q: %#v", d.Id(), res)
 
 	return resourceComputeImageRead(d, meta)
@@ -277,6 +375,9 @@ func resourceComputeImageRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("family", flattenComputeImageFamily(res["family"], d)); err != nil {
 		return fmt.Errorf("Error reading Image: %s", err)
 	}
+	if err := d.Set("guest_os_features", flattenComputeImageGuestOsFeatures(res["guestOsFeatures"], d)); err != nil {
+		return fmt.Errorf("Error reading Image: %s", err)
+	}
 	if err := d.Set("labels", flattenComputeImageLabels(res["labels"], d)); err != nil {
 		return fmt.Errorf("Error reading Image: %s", err)
 	}
@@ -292,6 +393,18 @@ func resourceComputeImageRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("source_disk", flattenComputeImageSourceDisk(res["sourceDisk"], d)); err != nil {
 		return fmt.Errorf("Error reading Image: %s", err)
 	}
+	if err := d.Set("source_image", flattenComputeImageSourceImage(res["sourceImage"], d)); err != nil {
+		return fmt.Errorf("Error reading Image: %s", err)
+	}
+	if err := d.Set("source_snapshot", flattenComputeImageSourceSnapshot(res["sourceSnapshot"], d)); err != nil {
+		return fmt.Errorf("Error reading Image: %s", err)
+	}
+	if err := d.Set("storage_locations", flattenComputeImageStorageLocations(res["storageLocations"], d)); err != nil {
+		return fmt.Errorf("Error reading Image: %s", err)
+	}
+	if err := d.Set("deprecated", flattenComputeImageDeprecated(res["deprecated"], d)); err != nil {
+		return fmt.Errorf("Error reading Image: %s", err)
+	}
 	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
 		return fmt.Errorf("Error reading Image: %s", err)
 	}
@@ -350,6 +463,18 @@ func resourceComputeImageUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("label_fingerprint")
 	}
 
+	if d.HasChange("deprecated") {
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+		if err := resourceComputeImageSetDeprecated(d, config, project, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+
+		d.SetPartial("deprecated")
+	}
+
 	d.Partial(false)
 
 	return resourceComputeImageRead(d, meta)
@@ -440,6 +565,28 @@ func flattenComputeImageFamily(v interface{}, d *schema.ResourceData) interface{
 	return v
 }
 
+func flattenComputeImageGuestOsFeatures(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"type": flattenComputeImageGuestOsFeaturesType(original["type"], d),
+		})
+	}
+	return transformed
+}
+func flattenComputeImageGuestOsFeaturesType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenComputeImageLabels(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -466,6 +613,68 @@ func flattenComputeImageSourceDisk(v interface{}, d *schema.ResourceData) interf
 	return ConvertSelfLinkToV1(v.(string))
 }
 
+func flattenComputeImageSourceImage(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeImageSourceSnapshot(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeImageStorageLocations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeImageDeprecated(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 || original["state"] == nil || original["state"] == "ACTIVE" {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["state"] =
+		flattenComputeImageDeprecatedState(original["state"], d)
+	transformed["replacement"] =
+		flattenComputeImageDeprecatedReplacement(original["replacement"], d)
+	transformed["deprecated"] =
+		flattenComputeImageDeprecatedDeprecated(original["deprecated"], d)
+	transformed["obsolete"] =
+		flattenComputeImageDeprecatedObsolete(original["obsolete"], d)
+	transformed["deleted"] =
+		flattenComputeImageDeprecatedDeleted(original["deleted"], d)
+	return []interface{}{transformed}
+}
+func flattenComputeImageDeprecatedState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeImageDeprecatedReplacement(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeImageDeprecatedDeprecated(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeImageDeprecatedObsolete(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeImageDeprecatedDeleted(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func expandComputeImageDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -478,6 +687,33 @@ func expandComputeImageFamily(v interface{}, d TerraformResourceData, config *Co
 	return v, nil
 }
 
+func expandComputeImageGuestOsFeatures(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedType, err := expandComputeImageGuestOsFeaturesType(original["type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedType); val.IsValid() && !isEmptyValue(val) {
+			transformed["type"] = transformedType
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeImageGuestOsFeaturesType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeImageLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
 	if v == nil {
 		return map[string]string{}, nil
@@ -562,3 +798,131 @@ func expandComputeImageSourceDisk(v interface{}, d TerraformResourceData, config
 	}
 	return f.RelativeLink(), nil
 }
+
+func expandComputeImageSourceImage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("images", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for source_image: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandComputeImageSourceSnapshot(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("snapshots", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for source_snapshot: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandComputeImageStorageLocations(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeImageDeprecated(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedState, err := expandComputeImageDeprecatedState(original["state"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedState); val.IsValid() && !isEmptyValue(val) {
+		transformed["state"] = transformedState
+	}
+
+	transformedReplacement, err := expandComputeImageDeprecatedReplacement(original["replacement"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedReplacement); val.IsValid() && !isEmptyValue(val) {
+		transformed["replacement"] = transformedReplacement
+	}
+
+	transformedDeprecated, err := expandComputeImageDeprecatedDeprecated(original["deprecated"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDeprecated); val.IsValid() && !isEmptyValue(val) {
+		transformed["deprecated"] = transformedDeprecated
+	}
+
+	transformedObsolete, err := expandComputeImageDeprecatedObsolete(original["obsolete"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedObsolete); val.IsValid() && !isEmptyValue(val) {
+		transformed["obsolete"] = transformedObsolete
+	}
+
+	transformedDeleted, err := expandComputeImageDeprecatedDeleted(original["deleted"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDeleted); val.IsValid() && !isEmptyValue(val) {
+		transformed["deleted"] = transformedDeleted
+	}
+
+	return transformed, nil
+}
+
+func expandComputeImageDeprecatedState(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeImageDeprecatedDeprecated(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeImageDeprecatedObsolete(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeImageDeprecatedDeleted(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeImageDeprecatedReplacement(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == nil || v.(string) == "" {
+		return v, nil
+	}
+	f, err := parseGlobalFieldValue("images", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for deprecated.0.replacement: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+// resourceComputeImageSetDeprecated sends the image's deprecation status to the
+// deprecate endpoint. Removing the deprecated block marks the image ACTIVE again.
+func resourceComputeImageSetDeprecated(d *schema.ResourceData, config *Config, project string, timeout time.Duration) error {
+	deprecated, err := expandComputeImageDeprecated(d.Get("deprecated"), d, config)
+	if err != nil {
+		return err
+	}
+	obj := map[string]interface{}{"state": "ACTIVE"}
+	if deprecated != nil {
+		obj = deprecated.(map[string]interface{})
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/images/{{name}}/deprecate")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Setting deprecation status of Image %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, timeout)
+	if err != nil {
+		return fmt.Errorf("Error deprecating Image %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(
+		config.clientCompute, op, project, "Deprecating Image",
+		int(timeout.Minutes()))
+}
@@ -107,6 +107,96 @@ func TestAccComputeImage_basedondisk(t *testing.T) {
 	})
 }
 
+func TestAccComputeImage_guestOsFeaturesAndStorageLocations(t *testing.T) {
+	t.Parallel()
+
+	name := "image-test-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImage_guestOsFeaturesAndStorageLocations(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_image.foobar", "guest_os_features.#", "2"),
+					resource.TestCheckResourceAttr("google_compute_image.foobar", "storage_locations.0", "us-central1"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_image.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeImage_basedOnSnapshot(t *testing.T) {
+	t.Parallel()
+
+	name := "image-test-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImage_basedOnSnapshot(name),
+			},
+			{
+				ResourceName:      "google_compute_image.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeImage_deprecated(t *testing.T) {
+	t.Parallel()
+
+	name := "image-test-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImage_deprecated(name, "DEPRECATED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_image.foobar", "deprecated.0.state", "DEPRECATED"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_image.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeImage_deprecated(name, "OBSOLETE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_image.foobar", "deprecated.0.state", "OBSOLETE"),
+				),
+			},
+			{
+				Config: testAccComputeImage_fromSourceImage(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_image.foobar", "deprecated.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_image.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeImageExists(n string, image *compute.Image) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -396,3 +486,80 @@ resource "google_compute_image" "foobar" {
 	source_disk = "${google_compute_disk.foobar.self_link}"
 }`, acctest.RandString(10), acctest.RandString(10))
 }
+
+func testAccComputeImage_guestOsFeaturesAndStorageLocations(name string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_image" "foobar" {
+	name = "%s"
+	source_image = "${data.google_compute_image.my_image.self_link}"
+	storage_locations = ["us-central1"]
+
+	guest_os_features {
+		type = "UEFI_COMPATIBLE"
+	}
+	guest_os_features {
+		type = "VIRTIO_SCSI_MULTIQUEUE"
+	}
+}`, name)
+}
+
+func testAccComputeImage_basedOnSnapshot(name string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_disk" "foobar" {
+	name = "%s"
+	zone = "us-central1-a"
+	image = "${data.google_compute_image.my_image.self_link}"
+}
+
+resource "google_compute_snapshot" "foobar" {
+	name = "%s"
+	source_disk = "${google_compute_disk.foobar.name}"
+	zone = "us-central1-a"
+}
+
+resource "google_compute_image" "foobar" {
+	name = "%s"
+	source_snapshot = "${google_compute_snapshot.foobar.self_link}"
+}`, name, name, name)
+}
+
+func testAccComputeImage_fromSourceImage(name string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_image" "foobar" {
+	name = "%s"
+	source_image = "${data.google_compute_image.my_image.self_link}"
+}`, name)
+}
+
+func testAccComputeImage_deprecated(name, state string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_image" "foobar" {
+	name = "%s"
+	source_image = "${data.google_compute_image.my_image.self_link}"
+
+	deprecated {
+		state = "%s"
+		replacement = "${data.google_compute_image.my_image.self_link}"
+	}
+}`, name, state)
+}
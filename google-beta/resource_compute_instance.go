@@ -114,6 +114,17 @@ func resourceComputeInstance() *schema.Resource {
 										ForceNew:         true,
 										DiffSuppressFunc: diskImageDiffSuppress,
 									},
+
+									"resource_policies": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											DiffSuppressFunc: compareSelfLinkOrResourceName,
+										},
+									},
 								},
 							},
 						},
@@ -248,6 +259,12 @@ func resourceComputeInstance() *schema.Resource {
 				Optional: true,
 			},
 
+			"desired_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RUNNING", "TERMINATED", "SUSPENDED"}, false),
+			},
+
 			"attached_disk": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -430,6 +447,25 @@ func resourceComputeInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"reservation_affinity": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     instanceReservationAffinityElemSchema(),
+			},
+
+			"resource_policies": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+			},
+
 			"scheduling": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -560,6 +596,11 @@ func resourceComputeInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"current_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"instance_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -598,6 +639,7 @@ func resourceComputeInstance() *schema.Resource {
 				},
 				suppressEmptyGuestAcceleratorDiff,
 			),
+			desiredStatusDiff,
 		),
 	}
 }
@@ -618,13 +660,13 @@ func getInstance(config *Config, d *schema.ResourceData) (*computeBeta.Instance,
 	return instance, nil
 }
 
-func getDisk(diskUri string, d *schema.ResourceData, config *Config) (*compute.Disk, error) {
+func getDisk(diskUri string, d *schema.ResourceData, config *Config) (*computeBeta.Disk, error) {
 	source, err := ParseDiskFieldValue(diskUri, d, config)
 	if err != nil {
 		return nil, err
 	}
 
-	disk, err := config.clientCompute.Disks.Get(source.Project, source.Zone, source.Name).Do()
+	disk, err := config.clientComputeBeta.Disks.Get(source.Project, source.Zone, source.Name).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -698,25 +740,37 @@ func expandComputeInstance(project string, zone *compute.Zone, d *schema.Resourc
 		return nil, fmt.Errorf("Error creating guest accelerators: %s", err)
 	}
 
+	reservationAffinity, err := expandReservationAffinity(d)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating reservation affinity: %s", err)
+	}
+
+	resourcePolicies, err := expandInstanceResourcePolicies(d.Get("resource_policies").([]interface{}), d, config)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the instance information
 	return &computeBeta.Instance{
-		CanIpForward:       d.Get("can_ip_forward").(bool),
-		Description:        d.Get("description").(string),
-		Disks:              disks,
-		MachineType:        machineTypeUrl,
-		Metadata:           metadata,
-		Name:               d.Get("name").(string),
-		NetworkInterfaces:  networkInterfaces,
-		Tags:               resourceInstanceTags(d),
-		Labels:             expandLabels(d),
-		ServiceAccounts:    expandServiceAccounts(d.Get("service_account").([]interface{})),
-		GuestAccelerators:  accels,
-		MinCpuPlatform:     d.Get("min_cpu_platform").(string),
-		Scheduling:         scheduling,
-		DeletionProtection: d.Get("deletion_protection").(bool),
-		Hostname:           d.Get("hostname").(string),
-		ForceSendFields:    []string{"CanIpForward", "DeletionProtection"},
-		ShieldedVmConfig:   expandShieldedVmConfigs(d),
+		CanIpForward:        d.Get("can_ip_forward").(bool),
+		Description:         d.Get("description").(string),
+		Disks:               disks,
+		MachineType:         machineTypeUrl,
+		Metadata:            metadata,
+		Name:                d.Get("name").(string),
+		NetworkInterfaces:   networkInterfaces,
+		Tags:                resourceInstanceTags(d),
+		Labels:              expandLabels(d),
+		ServiceAccounts:     expandServiceAccounts(d.Get("service_account").([]interface{})),
+		GuestAccelerators:   accels,
+		MinCpuPlatform:      d.Get("min_cpu_platform").(string),
+		Scheduling:          scheduling,
+		ReservationAffinity: reservationAffinity,
+		ResourcePolicies:    resourcePolicies,
+		DeletionProtection:  d.Get("deletion_protection").(bool),
+		Hostname:            d.Get("hostname").(string),
+		ForceSendFields:     []string{"CanIpForward", "DeletionProtection"},
+		ShieldedVmConfig:    expandShieldedVmConfigs(d),
 	}, nil
 }
 
@@ -765,6 +819,14 @@ func resourceComputeInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		return waitErr
 	}
 
+	// Instances are always created running, so bring them to the desired status afterwards.
+	if desiredStatus := d.Get("desired_status").(string); desiredStatus != "" && desiredStatus != "RUNNING" {
+		err := changeInstanceStatus(config, project, zone.Name, instance.Name, "RUNNING", desiredStatus, createTimeout)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceComputeInstanceRead(d, meta)
 }
 
@@ -935,9 +997,12 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("attached_disk", ads)
 	d.Set("scratch_disk", scratchDisks)
 	d.Set("scheduling", flattenScheduling(instance.Scheduling))
+	d.Set("reservation_affinity", flattenReservationAffinity(instance.ReservationAffinity))
+	d.Set("resource_policies", convertAndMapStringArr(convertStringArrToInterface(instance.ResourcePolicies), ConvertSelfLinkToV1))
 	d.Set("guest_accelerator", flattenGuestAccelerators(instance.GuestAccelerators))
 	d.Set("shielded_instance_config", flattenShieldedVmConfig(instance.ShieldedVmConfig))
 	d.Set("cpu_platform", instance.CpuPlatform)
+	d.Set("current_status", instance.Status)
 	d.Set("min_cpu_platform", instance.MinCpuPlatform)
 	d.Set("deletion_protection", instance.DeletionProtection)
 	d.Set("self_link", ConvertSelfLinkToV1(instance.SelfLink))
@@ -1312,22 +1377,49 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		d.SetPartial("deletion_protection")
 	}
 
+	desiredStatus := d.Get("desired_status").(string)
+	currentStatus := instance.Status
+	targetStatus := desiredStatus
+
 	// Attributes which can only be changed if the instance is stopped
-	if scopesChange || d.HasChange("service_account.0.email") || d.HasChange("machine_type") || d.HasChange("min_cpu_platform") {
-		if !d.Get("allow_stopping_for_update").(bool) {
-			return fmt.Errorf("Changing the machine_type, min_cpu_platform, or service_account on an instance requires stopping it. " +
-				"To acknowledge this, please set allow_stopping_for_update = true in your config.")
-		}
-		op, err := config.clientCompute.Instances.Stop(project, zone, instance.Name).Do()
-		if err != nil {
-			return errwrap.Wrapf("Error stopping instance: {{err}}", err)
-		}
+	if scopesChange || d.HasChange("service_account.0.email") || d.HasChange("machine_type") || d.HasChange("min_cpu_platform") || d.HasChange("resource_policies") {
+		// Instances that are already stopped, or that are meant to end up stopped, don't need
+		// permission to be stopped.
+		if currentStatus != "TERMINATED" {
+			if desiredStatus != "TERMINATED" && !d.Get("allow_stopping_for_update").(bool) {
+				return fmt.Errorf("Changing the machine_type, min_cpu_platform, service_account, or resource_policies on an instance requires stopping it. " +
+					"To acknowledge this, please set allow_stopping_for_update = true in your config, or set desired_status = \"TERMINATED\".")
+			}
 
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "stopping instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
-		if opErr != nil {
-			return opErr
+			// Without a desired_status, bring the instance back to the status it had before
+			// being stopped once the update is done.
+			if targetStatus == "" && (currentStatus == "RUNNING" || currentStatus == "SUSPENDED") {
+				targetStatus = currentStatus
+			}
+
+			// Suspended instances have to be resumed before they can be stopped.
+			if currentStatus == "SUSPENDED" {
+				err := changeInstanceStatus(config, project, zone, instance.Name, currentStatus, "RUNNING", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+				if err != nil {
+					return err
+				}
+			}
+
+			op, err := config.clientCompute.Instances.Stop(project, zone, instance.Name).Do()
+			if err != nil {
+				return errwrap.Wrapf("Error stopping instance: {{err}}", err)
+			}
+
+			opErr := computeOperationWaitTime(config.clientCompute, op, project, "stopping instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			if opErr != nil {
+				return opErr
+			}
 		}
 
+		var op *compute.Operation
+		var err error
+		var opErr error
+
 		if d.HasChange("machine_type") {
 			mt, err := ParseMachineTypesFieldValue(d.Get("machine_type").(string), d, config)
 			if err != nil {
@@ -1388,14 +1480,59 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			d.SetPartial("service_account")
 		}
 
-		op, err = config.clientCompute.Instances.Start(project, zone, instance.Name).Do()
-		if err != nil {
-			return errwrap.Wrapf("Error starting instance: {{err}}", err)
+		if d.HasChange("resource_policies") {
+			o, n := d.GetChange("resource_policies")
+			oldPolicies, err := expandInstanceResourcePolicies(o.([]interface{}), d, config)
+			if err != nil {
+				return err
+			}
+			newPolicies, err := expandInstanceResourcePolicies(n.([]interface{}), d, config)
+			if err != nil {
+				return err
+			}
+			oldSet := schema.NewSet(schema.HashString, convertStringArrToInterface(oldPolicies))
+			newSet := schema.NewSet(schema.HashString, convertStringArrToInterface(newPolicies))
+
+			// Detach the old policies first, since an instance can only have one
+			// resource policy at a time.
+			if removed := convertStringSet(oldSet.Difference(newSet)); len(removed) > 0 {
+				err := updateInstanceResourcePolicies(config, project, zone, instance.Name, "removeResourcePolicies", removed, d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return err
+				}
+			}
+			if added := convertStringSet(newSet.Difference(oldSet)); len(added) > 0 {
+				err := updateInstanceResourcePolicies(config, project, zone, instance.Name, "addResourcePolicies", added, d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return err
+				}
+			}
+			d.SetPartial("resource_policies")
 		}
 
-		opErr = computeOperationWaitTime(config.clientCompute, op, project, "starting instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
-		if opErr != nil {
-			return opErr
+		// Only restart instances that should end up running or suspended. A stopped instance
+		// can't be suspended, so suspended instances are started here and the status change
+		// below suspends them again.
+		if targetStatus == "RUNNING" || targetStatus == "SUSPENDED" {
+			op, err = config.clientCompute.Instances.Start(project, zone, instance.Name).Do()
+			if err != nil {
+				return errwrap.Wrapf("Error starting instance: {{err}}", err)
+			}
+
+			opErr = computeOperationWaitTime(config.clientCompute, op, project, "starting instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			if opErr != nil {
+				return opErr
+			}
+			currentStatus = "RUNNING"
+		} else {
+			currentStatus = "TERMINATED"
+		}
+	}
+
+	if targetStatus != "" && targetStatus != currentStatus {
+		err := changeInstanceStatus(config, project, zone, instance.Name, currentStatus, targetStatus, int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		if err != nil {
+			return err
 		}
 	}
 
@@ -1421,6 +1558,92 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 	return resourceComputeInstanceRead(d, meta)
 }
 
+func expandInstanceResourcePolicies(raw []interface{}, d TerraformResourceData, config *Config) ([]string, error) {
+	var resourcePolicies []string
+	for _, v := range raw {
+		policy, err := parseRegionalFieldValue("resourcePolicies", v.(string), "project", "region", "zone", d, config, false)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for resource_policies: %s", err)
+		}
+		resourcePolicies = append(resourcePolicies, policy.RelativeLink())
+	}
+	return resourcePolicies, nil
+}
+
+// updateInstanceResourcePolicies attaches or detaches resource policies using
+// the instance's addResourcePolicies or removeResourcePolicies method.
+func updateInstanceResourcePolicies(config *Config, project, zone, name, method string, policies []string, timeout time.Duration) error {
+	url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instances/%s/%s", project, zone, name, method)
+	obj := map[string]interface{}{
+		"resourcePolicies": policies,
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, timeout)
+	if err != nil {
+		return fmt.Errorf("Error updating resource policies: %s", err)
+	}
+
+	op := &computeBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeSharedOperationWaitTime(config.clientCompute, op, project, int(timeout.Minutes()), "updating resource policies")
+}
+
+// changeInstanceStatus moves an instance from its current status to the desired one,
+// starting, stopping, suspending or resuming it as needed.
+func changeInstanceStatus(config *Config, project, zone, name, currentStatus, desiredStatus string, timeoutMinutes int) error {
+	var op *computeBeta.Operation
+	var err error
+	var action string
+
+	switch desiredStatus {
+	case "RUNNING":
+		if currentStatus == "SUSPENDED" {
+			action = "resuming instance"
+			op, err = config.clientComputeBeta.Instances.Resume(project, zone, name, &computeBeta.InstancesResumeRequest{}).Do()
+		} else {
+			action = "starting instance"
+			op, err = config.clientComputeBeta.Instances.Start(project, zone, name).Do()
+		}
+	case "TERMINATED":
+		action = "stopping instance"
+		op, err = config.clientComputeBeta.Instances.Stop(project, zone, name).Do()
+	case "SUSPENDED":
+		if currentStatus == "TERMINATED" {
+			return fmt.Errorf("Instance %s is stopped and can't be suspended. Set desired_status to \"RUNNING\" first.", name)
+		}
+		action = "suspending instance"
+		op, err = config.clientComputeBeta.Instances.Suspend(project, zone, name).Do()
+	default:
+		return fmt.Errorf("Unsupported desired_status %q", desiredStatus)
+	}
+	if err != nil {
+		return fmt.Errorf("Error %s: %s", action, err)
+	}
+
+	return computeSharedOperationWaitTime(config.clientCompute, op, project, timeoutMinutes, action)
+}
+
+// desiredStatusDiff forces an update when an instance's status has drifted from its
+// desired_status, e.g. because it was stopped outside of Terraform.
+func desiredStatusDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	desiredStatus := diff.Get("desired_status").(string)
+	if desiredStatus == "" {
+		return nil
+	}
+
+	if currentStatus, _ := diff.GetChange("current_status"); currentStatus.(string) != desiredStatus {
+		return diff.SetNew("current_status", desiredStatus)
+	}
+	return nil
+}
+
 func expandAttachedDisk(diskConfig map[string]interface{}, d *schema.ResourceData, meta interface{}) (*computeBeta.AttachedDisk, error) {
 	config := meta.(*Config)
 
@@ -1650,6 +1873,18 @@ func expandBootDisk(d *schema.ResourceData, config *Config, zone *compute.Zone,
 
 			disk.InitializeParams.SourceImage = imageUrl
 		}
+
+		if v, ok := d.GetOk("boot_disk.0.initialize_params.0.resource_policies"); ok {
+			policies := make([]string, 0, len(v.([]interface{})))
+			for _, raw := range v.([]interface{}) {
+				policy, err := parseRegionalFieldValue("resourcePolicies", raw.(string), "project", "region", "zone", d, config, false)
+				if err != nil {
+					return nil, fmt.Errorf("Invalid value for boot_disk.0.initialize_params.0.resource_policies: %s", err)
+				}
+				policies = append(policies, policy.RelativeLink())
+			}
+			disk.InitializeParams.ResourcePolicies = policies
+		}
 	}
 
 	return disk, nil
@@ -1680,8 +1915,9 @@ func flattenBootDisk(d *schema.ResourceData, disk *computeBeta.AttachedDisk, con
 			"type": GetResourceNameFromSelfLink(diskDetails.Type),
 			// If the config specifies a family name that doesn't match the image name, then
 			// the diff won't be properly suppressed. See DiffSuppressFunc for this field.
-			"image": diskDetails.SourceImage,
-			"size":  diskDetails.SizeGb,
+			"image":             diskDetails.SourceImage,
+			"size":              diskDetails.SizeGb,
+			"resource_policies": convertAndMapStringArr(convertStringArrToInterface(diskDetails.ResourcePolicies), ConvertSelfLinkToV1),
 		}}
 	}
 
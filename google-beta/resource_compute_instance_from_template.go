@@ -68,9 +68,19 @@ func computeInstanceFromTemplateSchema() map[string]*schema.Schema {
 	})
 
 	s["source_instance_template"] = &schema.Schema{
-		Type:     schema.TypeString,
-		Required: true,
-		ForceNew: true,
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"source_machine_image"},
+	}
+
+	// Instances can also be created from a machine image instead of a template.
+	s["source_machine_image"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: compareSelfLinkOrResourceName,
+		ConflictsWith:    []string{"source_instance_template"},
 	}
 
 	return s
@@ -111,19 +121,35 @@ func resourceComputeInstanceFromTemplateCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	tpl, err := ParseInstanceTemplateFieldValue(d.Get("source_instance_template").(string), d, config)
-	if err != nil {
-		return err
-	}
+	insertCall := config.clientComputeBeta.Instances.Insert(project, zone.Name, instance)
+	if v, ok := d.GetOk("source_instance_template"); ok {
+		tpl, err := ParseInstanceTemplateFieldValue(v.(string), d, config)
+		if err != nil {
+			return err
+		}
 
-	it, err := config.clientComputeBeta.InstanceTemplates.Get(project, tpl.Name).Do()
-	if err != nil {
-		return err
-	}
+		it, err := config.clientComputeBeta.InstanceTemplates.Get(project, tpl.Name).Do()
+		if err != nil {
+			return err
+		}
 
-	instance.Disks, err = adjustInstanceFromTemplateDisks(d, config, it, zone, project)
-	if err != nil {
-		return err
+		instance.Disks, err = adjustInstanceFromTemplateDisks(d, config, it, zone, project)
+		if err != nil {
+			return err
+		}
+
+		insertCall = insertCall.SourceInstanceTemplate(tpl.RelativeLink())
+	} else if v, ok := d.GetOk("source_machine_image"); ok {
+		// Any properties left unset, including disks and network interfaces, are
+		// taken from the machine image.
+		mi, err := ParseMachineImageFieldValue(v.(string), d, config)
+		if err != nil {
+			return err
+		}
+
+		instance.SourceMachineImage = mi.RelativeLink()
+	} else {
+		return fmt.Errorf("One of source_instance_template or source_machine_image must be set")
 	}
 
 	// Force send all top-level fields that have been set in case they're overridden to zero values.
@@ -148,7 +174,7 @@ func resourceComputeInstanceFromTemplateCreate(d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[INFO] Requesting instance creation")
-	op, err := config.clientComputeBeta.Instances.Insert(project, zone.Name, instance).SourceInstanceTemplate(tpl.RelativeLink()).Do()
+	op, err := insertCall.Do()
 	if err != nil {
 		return fmt.Errorf("Error creating instance: %s", err)
 	}
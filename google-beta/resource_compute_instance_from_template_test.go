@@ -191,6 +191,54 @@ func TestAccComputeInstanceFromTemplate_overrideMetadataDotStartupScript(t *test
 
 }
 
+func TestAccComputeInstanceFromTemplate_fromMachineImage(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	sourceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	imageName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	resourceName := "google_compute_instance_from_template.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceFromTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceFromTemplate_fromMachineImage(sourceName, imageName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+
+					// Check that fields were set based on the machine image
+					resource.TestCheckResourceAttr(resourceName, "machine_type", "n1-standard-1"),
+					resource.TestCheckResourceAttr(resourceName, "attached_disk.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+					testAccCheckComputeInstanceMetadata(&instance, "foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeInstanceFromTemplate_noSource_shouldFail(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceFromTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeInstanceFromTemplate_noSource(instanceName),
+				ExpectError: regexp.MustCompile("One of source_instance_template or source_machine_image must be set"),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeInstanceFromTemplateDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -576,3 +624,64 @@ resource "google_compute_instance_from_template" "inst" {
 }
 `, template, instance)
 }
+
+func testAccComputeInstanceFromTemplate_fromMachineImage(source, image, instance string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_disk" "foobar" {
+	name  = "%s"
+	image = "${data.google_compute_image.my_image.self_link}"
+	size  = 10
+	zone  = "us-central1-a"
+}
+
+resource "google_compute_instance" "source" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	attached_disk {
+		source = "${google_compute_disk.foobar.self_link}"
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	metadata = {
+		foo = "bar"
+	}
+}
+
+resource "google_compute_machine_image" "foobar" {
+	name            = "%s"
+	source_instance = "${google_compute_instance.source.self_link}"
+}
+
+resource "google_compute_instance_from_template" "foobar" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	source_machine_image = "${google_compute_machine_image.foobar.self_link}"
+}
+`, source, source, image, instance)
+}
+
+func testAccComputeInstanceFromTemplate_noSource(instance string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_from_template" "foobar" {
+	name = "%s"
+	zone = "us-central1-a"
+}
+`, instance)
+}
@@ -226,6 +226,26 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"stateful_disk": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"delete_rule": {
+							Type:         schema.TypeString,
+							Default:      "NEVER",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -280,6 +300,7 @@ func resourceComputeInstanceGroupManagerCreate(d *schema.ResourceData, meta inte
 		AutoHealingPolicies: expandAutoHealingPolicies(d.Get("auto_healing_policies").([]interface{})),
 		Versions:            expandVersions(d.Get("version").([]interface{})),
 		UpdatePolicy:        expandUpdatePolicy(d.Get("update_policy").([]interface{})),
+		StatefulPolicy:      expandStatefulPolicy(d.Get("stateful_disk").(*schema.Set).List()),
 		// Force send TargetSize to allow a value of 0.
 		ForceSendFields: []string{"TargetSize"},
 	}
@@ -417,6 +438,9 @@ func resourceComputeInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 	if err = d.Set("update_policy", flattenUpdatePolicy(manager.UpdatePolicy)); err != nil {
 		return fmt.Errorf("Error setting update_policy in state: %s", err.Error())
 	}
+	if err = d.Set("stateful_disk", flattenStatefulPolicy(manager.StatefulPolicy)); err != nil {
+		return fmt.Errorf("Error setting stateful_disk in state: %s", err.Error())
+	}
 
 	if d.Get("wait_for_instances").(bool) {
 		conf := resource.StateChangeConf{
@@ -473,8 +497,17 @@ func resourceComputeInstanceGroupManagerUpdate(d *schema.ResourceData, meta inte
 		change = true
 	}
 
+	if d.HasChange("stateful_disk") {
+		updatedManager.StatefulPolicy = expandStatefulPolicy(d.Get("stateful_disk").(*schema.Set).List())
+		if updatedManager.StatefulPolicy == nil {
+			updatedManager.NullFields = append(updatedManager.NullFields, "StatefulPolicy")
+		}
+		change = true
+	}
+
 	if change {
-		op, err := config.clientComputeBeta.InstanceGroupManagers.Patch(project, zone, d.Get("name").(string), updatedManager).Do()
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instanceGroupManagers/%s", project, zone, d.Get("name").(string))
+		op, err := patchInstanceGroupManager(config, url, updatedManager, removedStatefulDisks(d), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating managed group instances: %s", err)
 		}
@@ -678,6 +711,77 @@ func expandUpdatePolicy(configured []interface{}) *computeBeta.InstanceGroupMana
 	return updatePolicy
 }
 
+func expandStatefulPolicy(configured []interface{}) *computeBeta.StatefulPolicy {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	disks := make(map[string]computeBeta.StatefulPolicyPreservedStateDiskDevice)
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		disks[data["device_name"].(string)] = computeBeta.StatefulPolicyPreservedStateDiskDevice{
+			AutoDelete: data["delete_rule"].(string),
+		}
+	}
+	return &computeBeta.StatefulPolicy{
+		PreservedState: &computeBeta.StatefulPolicyPreservedState{
+			Disks: disks,
+		},
+	}
+}
+
+// removedStatefulDisks returns the device names of the stateful disks that were
+// removed from the config.
+func removedStatefulDisks(d *schema.ResourceData) []string {
+	o, n := d.GetChange("stateful_disk")
+
+	configured := make(map[string]bool)
+	for _, raw := range n.(*schema.Set).List() {
+		configured[raw.(map[string]interface{})["device_name"].(string)] = true
+	}
+
+	var removed []string
+	for _, raw := range o.(*schema.Set).List() {
+		deviceName := raw.(map[string]interface{})["device_name"].(string)
+		if !configured[deviceName] {
+			removed = append(removed, deviceName)
+		}
+	}
+	return removed
+}
+
+// patchInstanceGroupManager sends a PATCH for a zonal or regional instance group
+// manager. PATCH merges the stateful policy's disks map, so a removed disk has to
+// be sent as a null entry, which the compute client can't express; the request is
+// sent as raw JSON instead.
+func patchInstanceGroupManager(config *Config, url string, manager *computeBeta.InstanceGroupManager, removedStatefulDisks []string, timeout time.Duration) (*computeBeta.Operation, error) {
+	obj, err := ConvertToMap(manager)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy, ok := obj["statefulPolicy"].(map[string]interface{}); ok {
+		if preservedState, ok := policy["preservedState"].(map[string]interface{}); ok {
+			if disks, ok := preservedState["disks"].(map[string]interface{}); ok {
+				for _, deviceName := range removedStatefulDisks {
+					disks[deviceName] = nil
+				}
+			}
+		}
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &computeBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
 func flattenAutoHealingPolicies(autoHealingPolicies []*computeBeta.InstanceGroupManagerAutoHealingPolicy) []map[string]interface{} {
 	autoHealingPoliciesSchema := make([]map[string]interface{}, 0, len(autoHealingPolicies))
 	for _, autoHealingPolicy := range autoHealingPolicies {
@@ -717,6 +821,23 @@ func flattenUpdatePolicy(updatePolicy *computeBeta.InstanceGroupManagerUpdatePol
 	return results
 }
 
+func flattenStatefulPolicy(statefulPolicy *computeBeta.StatefulPolicy) []map[string]interface{} {
+	if statefulPolicy == nil || statefulPolicy.PreservedState == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(statefulPolicy.PreservedState.Disks))
+	for deviceName, disk := range statefulPolicy.PreservedState.Disks {
+		data := map[string]interface{}{
+			"device_name": deviceName,
+			"delete_rule": disk.AutoDelete,
+		}
+
+		result = append(result, data)
+	}
+	return result
+}
+
 func resourceInstanceGroupManagerStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("wait_for_instances", false)
 	config := meta.(*Config)
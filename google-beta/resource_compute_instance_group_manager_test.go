@@ -992,3 +992,214 @@ resource "google_compute_instance_group_manager" "igm-basic" {
 }
 	`, primaryTemplate, canaryTemplate, igm)
 }
+
+func TestAccInstanceGroupManager_stateful(t *testing.T) {
+	t.Parallel()
+
+	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceGroupManagerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceGroupManager_stateful(template, igm),
+			},
+			{
+				ResourceName:      "google_compute_instance_group_manager.igm-basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceGroupManager_statefulUpdated(template, igm),
+			},
+			{
+				ResourceName:      "google_compute_instance_group_manager.igm-basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Dropping one of two stateful disks has to remove it on the server too.
+				Config: testAccInstanceGroupManager_stateful(template, igm),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance_group_manager.igm-basic", "stateful_disk.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_instance_group_manager.igm-basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceGroupManager_statefulRemoved(template, igm),
+			},
+			{
+				ResourceName:      "google_compute_instance_group_manager.igm-basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceGroupManager_stateful(template, igm string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "igm-basic" {
+	name           = "%s"
+	machine_type   = "n1-standard-1"
+	can_ip_forward = false
+	tags           = ["foo", "bar"]
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = true
+		device_name  = "my-stateful-disk"
+	}
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = false
+		device_name  = "my-stateful-disk2"
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	service_account {
+		scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+	}
+}
+
+resource "google_compute_instance_group_manager" "igm-basic" {
+	description = "Terraform test instance group manager"
+	name        = "%s"
+	version {
+		instance_template = "${google_compute_instance_template.igm-basic.self_link}"
+		name              = "prod"
+	}
+	base_instance_name = "igm-basic"
+	zone               = "us-central1-c"
+	target_size        = 2
+	stateful_disk {
+		device_name = "my-stateful-disk"
+		delete_rule = "NEVER"
+	}
+}
+`, template, igm)
+}
+
+func testAccInstanceGroupManager_statefulUpdated(template, igm string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "igm-basic" {
+	name           = "%s"
+	machine_type   = "n1-standard-1"
+	can_ip_forward = false
+	tags           = ["foo", "bar"]
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = true
+		device_name  = "my-stateful-disk"
+	}
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = false
+		device_name  = "my-stateful-disk2"
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	service_account {
+		scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+	}
+}
+
+resource "google_compute_instance_group_manager" "igm-basic" {
+	description = "Terraform test instance group manager"
+	name        = "%s"
+	version {
+		instance_template = "${google_compute_instance_template.igm-basic.self_link}"
+		name              = "prod"
+	}
+	base_instance_name = "igm-basic"
+	zone               = "us-central1-c"
+	target_size        = 2
+	stateful_disk {
+		device_name = "my-stateful-disk"
+		delete_rule = "NEVER"
+	}
+
+	stateful_disk {
+		device_name = "my-stateful-disk2"
+		delete_rule = "ON_PERMANENT_INSTANCE_DELETION"
+	}
+}
+`, template, igm)
+}
+
+func testAccInstanceGroupManager_statefulRemoved(template, igm string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "igm-basic" {
+	name           = "%s"
+	machine_type   = "n1-standard-1"
+	can_ip_forward = false
+	tags           = ["foo", "bar"]
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = true
+		device_name  = "my-stateful-disk"
+	}
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = false
+		device_name  = "my-stateful-disk2"
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	service_account {
+		scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+	}
+}
+
+resource "google_compute_instance_group_manager" "igm-basic" {
+	description = "Terraform test instance group manager"
+	name        = "%s"
+	version {
+		instance_template = "${google_compute_instance_template.igm-basic.self_link}"
+		name              = "prod"
+	}
+	base_instance_name = "igm-basic"
+	zone               = "us-central1-c"
+	target_size        = 2
+}
+`, template, igm)
+}
@@ -314,6 +314,26 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 				Computed: true,
 			},
 
+			"reservation_affinity": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     instanceReservationAffinityElemSchema(),
+			},
+
+			"resource_policies": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+			},
+
 			"scheduling": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -677,19 +697,32 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	reservationAffinity, err := expandReservationAffinity(d)
+	if err != nil {
+		return err
+	}
+
+	// Instance templates take resource policy names rather than links.
+	var resourcePolicies []string
+	for _, raw := range d.Get("resource_policies").([]interface{}) {
+		resourcePolicies = append(resourcePolicies, GetResourceNameFromSelfLink(raw.(string)))
+	}
+
 	instanceProperties := &computeBeta.InstanceProperties{
-		CanIpForward:      d.Get("can_ip_forward").(bool),
-		Description:       d.Get("instance_description").(string),
-		GuestAccelerators: expandInstanceTemplateGuestAccelerators(d, config),
-		MachineType:       d.Get("machine_type").(string),
-		MinCpuPlatform:    d.Get("min_cpu_platform").(string),
-		Disks:             disks,
-		Metadata:          metadata,
-		NetworkInterfaces: networks,
-		Scheduling:        scheduling,
-		ServiceAccounts:   expandServiceAccounts(d.Get("service_account").([]interface{})),
-		Tags:              resourceInstanceTags(d),
-		ShieldedVmConfig:  expandShieldedVmConfigs(d),
+		CanIpForward:        d.Get("can_ip_forward").(bool),
+		Description:         d.Get("instance_description").(string),
+		GuestAccelerators:   expandInstanceTemplateGuestAccelerators(d, config),
+		MachineType:         d.Get("machine_type").(string),
+		MinCpuPlatform:      d.Get("min_cpu_platform").(string),
+		Disks:               disks,
+		Metadata:            metadata,
+		NetworkInterfaces:   networks,
+		Scheduling:          scheduling,
+		ServiceAccounts:     expandServiceAccounts(d.Get("service_account").([]interface{})),
+		Tags:                resourceInstanceTags(d),
+		ShieldedVmConfig:    expandShieldedVmConfigs(d),
+		ReservationAffinity: reservationAffinity,
+		ResourcePolicies:    resourcePolicies,
 	}
 
 	if _, ok := d.GetOk("labels"); ok {
@@ -868,6 +901,14 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error setting scheduling: %s", err)
 		}
 	}
+	if instanceTemplate.Properties.ReservationAffinity != nil {
+		if err = d.Set("reservation_affinity", flattenReservationAffinity(instanceTemplate.Properties.ReservationAffinity)); err != nil {
+			return fmt.Errorf("Error setting reservation_affinity: %s", err)
+		}
+	}
+	if err = d.Set("resource_policies", instanceTemplate.Properties.ResourcePolicies); err != nil {
+		return fmt.Errorf("Error setting resource_policies: %s", err)
+	}
 	if instanceTemplate.Properties.Tags != nil {
 		if err = d.Set("tags", instanceTemplate.Properties.Tags.Items); err != nil {
 			return fmt.Errorf("Error setting tags: %s", err)
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -591,6 +592,107 @@ func TestAccComputeInstanceTemplate_shieldedVmConfig2(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstanceTemplate_reservationAffinities(t *testing.T) {
+	t.Parallel()
+
+	var template computeBeta.InstanceTemplate
+	templateName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceTemplate_reservationAffinity_nonSpecificReservation(templateName, "NO_RESERVATION"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &template),
+					testAccCheckComputeInstanceTemplateHasReservationAffinity(&template, "NO_RESERVATION"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeInstanceTemplate_reservationAffinity_nonSpecificReservation(templateName, "ANY_RESERVATION"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &template),
+					testAccCheckComputeInstanceTemplateHasReservationAffinity(&template, "ANY_RESERVATION"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeInstanceTemplate_reservationAffinity_specificReservation(templateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &template),
+					testAccCheckComputeInstanceTemplateHasReservationAffinity(&template, "SPECIFIC_RESERVATION", templateName),
+				),
+			},
+			{
+				ResourceName:      "google_compute_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeInstanceTemplate_resourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	var template computeBeta.InstanceTemplate
+	templateName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceTemplate_resourcePolicies(templateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &template),
+					testAccCheckComputeInstanceTemplateHasResourcePolicy(&template, templateName),
+				),
+			},
+			{
+				ResourceName:      "google_compute_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeInstanceTemplate_reservationAffinityDefault(t *testing.T) {
+	t.Parallel()
+
+	config := testAccComputeInstanceTemplate_basic()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// The API default shouldn't cause a diff when the block is omitted.
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeInstanceTemplateDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -922,6 +1024,40 @@ func testAccCheckComputeInstanceTemplateLacksShieldedVmConfig(instanceTemplate *
 	}
 }
 
+func testAccCheckComputeInstanceTemplateHasResourcePolicy(instanceTemplate *computeBeta.InstanceTemplate, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policies := instanceTemplate.Properties.ResourcePolicies
+		if len(policies) != 1 || policies[0] != policyName {
+			return fmt.Errorf("Wrong resource policies: expected [%s], got %v", policyName, policies)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeInstanceTemplateHasReservationAffinity(instanceTemplate *computeBeta.InstanceTemplate, reservationType string, specificReservationNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		affinity := instanceTemplate.Properties.ReservationAffinity
+		if affinity == nil {
+			return fmt.Errorf("Expected reservation affinity, got none")
+		}
+
+		if affinity.ConsumeReservationType != reservationType {
+			return fmt.Errorf("Wrong reservationAffinity consumeReservationType: expected %s, got %s", reservationType, affinity.ConsumeReservationType)
+		}
+
+		if reservationType != "SPECIFIC_RESERVATION" {
+			return nil
+		}
+
+		if !reflect.DeepEqual(affinity.Values, specificReservationNames) {
+			return fmt.Errorf("Wrong reservationAffinity values: expected %v, got %v", specificReservationNames, affinity.Values)
+		}
+
+		return nil
+	}
+}
+
 func testAccComputeInstanceTemplate_basic() string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
@@ -1720,3 +1856,99 @@ resource "google_compute_instance_template" "foobar" {
 	}
 }`, acctest.RandString(10), enableSecureBoot, enableVtpm, enableIntegrityMonitoring)
 }
+
+func testAccComputeInstanceTemplate_reservationAffinity_nonSpecificReservation(templateName, consumeReservationType string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "foobar" {
+	name           = "%s"
+	machine_type   = "n1-standard-1"
+	can_ip_forward = false
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = true
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	reservation_affinity {
+		type = "%s"
+	}
+}`, templateName, consumeReservationType)
+}
+
+func testAccComputeInstanceTemplate_resourcePolicies(templateName string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_resource_policy" "foo" {
+	name   = "%s"
+	region = "us-central1"
+
+	group_placement_policy {
+		availability_domain_count = 2
+	}
+}
+
+resource "google_compute_instance_template" "foobar" {
+	name           = "%s"
+	machine_type   = "n1-standard-1"
+	can_ip_forward = false
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = true
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	resource_policies = ["${google_compute_resource_policy.foo.name}"]
+}`, templateName, templateName)
+}
+
+func testAccComputeInstanceTemplate_reservationAffinity_specificReservation(templateName string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "foobar" {
+	name           = "%s"
+	machine_type   = "n1-standard-1"
+	can_ip_forward = false
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = true
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	reservation_affinity {
+		type = "SPECIFIC_RESERVATION"
+
+		specific_reservation {
+			key    = "compute.googleapis.com/reservation-name"
+			values = ["%s"]
+		}
+	}
+}`, templateName, templateName)
+}
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -1001,6 +1002,196 @@ func TestAccComputeInstance_minCpuPlatform(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_desiredStatus(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "n1-standard-1", "RUNNING"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "current_status", "RUNNING"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{"desired_status"}),
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "n1-standard-1", "TERMINATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "current_status", "TERMINATED"),
+				),
+			},
+			{
+				// Stopped instances can change machine type without allow_stopping_for_update.
+				Config: testAccComputeInstance_desiredStatus(instanceName, "n1-standard-2", "TERMINATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "current_status", "TERMINATED"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "machine_type", "n1-standard-2"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "n1-standard-2", "RUNNING"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "current_status", "RUNNING"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "n1-standard-2", "SUSPENDED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "current_status", "SUSPENDED"),
+				),
+			},
+			{
+				// Suspended instances are stopped for the update and suspended again afterwards.
+				Config: testAccComputeInstance_desiredStatusAllowStopping(instanceName, "n1-standard-1", "SUSPENDED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "current_status", "SUSPENDED"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "machine_type", "n1-standard-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeInstance_reservationAffinities(t *testing.T) {
+	t.Parallel()
+
+	var instance computeBeta.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_reservationAffinity_nonSpecificReservationConfig(instanceName, "NO_RESERVATION"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceHasReservationAffinity(&instance, "NO_RESERVATION"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+			{
+				Config: testAccComputeInstance_reservationAffinity_nonSpecificReservationConfig(instanceName, "ANY_RESERVATION"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceHasReservationAffinity(&instance, "ANY_RESERVATION"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+			{
+				Config: testAccComputeInstance_reservationAffinity_specificReservationConfig(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceHasReservationAffinity(&instance, "SPECIFIC_RESERVATION", instanceName),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+		},
+	})
+}
+
+func TestAccComputeInstance_reservationAffinityDefault(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "reservation_affinity.0.type", "ANY_RESERVATION"),
+				),
+			},
+			// The API default shouldn't cause a diff when the block is omitted.
+			{
+				Config:             testAccComputeInstance_basic(instanceName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccComputeInstance_resourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	var instance computeBeta.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_resourcePolicies(instanceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceHasResourcePolicy(&instance, instanceName),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{"allow_stopping_for_update"}),
+			{
+				Config: testAccComputeInstance_resourcePolicies(instanceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceHasNoResourcePolicy(&instance),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{"allow_stopping_for_update"}),
+			{
+				Config: testAccComputeInstance_resourcePolicies(instanceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceHasResourcePolicy(&instance, instanceName),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{"allow_stopping_for_update"}),
+		},
+	})
+}
+
+func TestAccComputeInstance_bootDiskResourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	policyName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_bootDiskResourcePolicies(instanceName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "boot_disk.0.initialize_params.0.resource_policies.#", "1"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+		},
+	})
+}
+
 func TestAccComputeInstance_deletionProtectionExplicitFalse(t *testing.T) {
 	t.Parallel()
 
@@ -1768,6 +1959,52 @@ func testAccCheckComputeInstanceLacksShieldedVmConfig(instance *computeBeta.Inst
 	}
 }
 
+func testAccCheckComputeInstanceHasResourcePolicy(instance *computeBeta.Instance, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(instance.ResourcePolicies) != 1 {
+			return fmt.Errorf("Expected one resource policy, got %v", instance.ResourcePolicies)
+		}
+
+		if GetResourceNameFromSelfLink(instance.ResourcePolicies[0]) != policyName {
+			return fmt.Errorf("Wrong resource policy: expected %s, got %s", policyName, instance.ResourcePolicies[0])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeInstanceHasNoResourcePolicy(instance *computeBeta.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(instance.ResourcePolicies) != 0 {
+			return fmt.Errorf("Expected no resource policies, got %v", instance.ResourcePolicies)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeInstanceHasReservationAffinity(instance *computeBeta.Instance, reservationType string, specificReservationNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance.ReservationAffinity == nil {
+			return fmt.Errorf("Expected reservation affinity, got none")
+		}
+
+		if instance.ReservationAffinity.ConsumeReservationType != reservationType {
+			return fmt.Errorf("Wrong reservationAffinity consumeReservationType: expected %s, got %s", reservationType, instance.ReservationAffinity.ConsumeReservationType)
+		}
+
+		if reservationType != "SPECIFIC_RESERVATION" {
+			return nil
+		}
+
+		if !reflect.DeepEqual(instance.ReservationAffinity.Values, specificReservationNames) {
+			return fmt.Errorf("Wrong reservationAffinity values: expected %v, got %v", specificReservationNames, instance.ReservationAffinity.Values)
+		}
+
+		return nil
+	}
+}
+
 func testAccComputeInstance_basic(instance string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
@@ -3177,6 +3414,214 @@ resource "google_compute_instance" "foobar" {
 }`, instance)
 }
 
+func testAccComputeInstance_bootDiskResourcePolicies(instance, policy string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_resource_policy" "foo" {
+	name   = "%s"
+	region = "us-central1"
+	snapshot_schedule_policy {
+		schedule {
+			daily_schedule {
+				days_in_cycle = 1
+				start_time    = "04:00"
+			}
+		}
+	}
+}
+
+resource "google_compute_instance" "foobar" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	zone = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image             = "${data.google_compute_image.my_image.self_link}"
+			resource_policies = ["${google_compute_resource_policy.foo.self_link}"]
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+}`, policy, instance)
+}
+
+func testAccComputeInstance_desiredStatus(instance, machineType, desiredStatus string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance" "foobar" {
+	name = "%s"
+	machine_type = "%s"
+	zone = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	desired_status = "%s"
+}`, instance, machineType, desiredStatus)
+}
+
+func testAccComputeInstance_desiredStatusAllowStopping(instance, machineType, desiredStatus string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance" "foobar" {
+	name = "%s"
+	machine_type = "%s"
+	zone = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	desired_status = "%s"
+	allow_stopping_for_update = true
+}`, instance, machineType, desiredStatus)
+}
+
+func testAccComputeInstance_reservationAffinity_nonSpecificReservationConfig(instanceName, reservationType string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance" "foobar" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	zone = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	reservation_affinity {
+		type = "%s"
+	}
+}`, instanceName, reservationType)
+}
+
+func testAccComputeInstance_resourcePolicies(instanceName string, attached bool) string {
+	resourcePolicies := ""
+	if attached {
+		resourcePolicies = `resource_policies = ["${google_compute_resource_policy.foo.self_link}"]`
+	}
+
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_resource_policy" "foo" {
+	name   = "%s"
+	region = "us-central1"
+
+	group_placement_policy {
+		availability_domain_count = 2
+	}
+}
+
+resource "google_compute_instance" "foobar" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	zone = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	allow_stopping_for_update = true
+
+	%s
+}`, instanceName, instanceName, resourcePolicies)
+}
+
+func testAccComputeInstance_reservationAffinity_specificReservationConfig(instanceName string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_reservation" "reservation" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	specific_reservation {
+		count = 1
+		instance_properties {
+			machine_type = "n1-standard-1"
+		}
+	}
+
+	specific_reservation_required = true
+}
+
+resource "google_compute_instance" "foobar" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	zone = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	reservation_affinity {
+		type = "SPECIFIC_RESERVATION"
+
+		specific_reservation {
+			key    = "compute.googleapis.com/reservation-name"
+			values = ["${google_compute_reservation.reservation.name}"]
+		}
+	}
+}`, instanceName, instanceName)
+}
+
 func testAccComputeInstance_primaryAliasIpRange(instance string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
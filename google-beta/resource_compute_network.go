@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -71,6 +72,12 @@ func resourceComputeNetwork() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"REGIONAL", "GLOBAL", ""}, false),
 			},
+			"mtu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
 
 			"gateway_ipv4": {
 				Type:     schema.TypeString,
@@ -129,6 +136,12 @@ func resourceComputeNetworkCreate(d *schema.ResourceData, meta interface{}) erro
 	} else if !isEmptyValue(reflect.ValueOf(routingConfigProp)) {
 		obj["routingConfig"] = routingConfigProp
 	}
+	mtuProp, err := expandComputeNetworkMtu(d.Get("mtu"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("mtu"); !isEmptyValue(reflect.ValueOf(mtuProp)) && (ok || !reflect.DeepEqual(v, mtuProp)) {
+		obj["mtu"] = mtuProp
+	}
 
 	obj, err = resourceComputeNetworkEncoder(d, meta, obj)
 	if err != nil {
@@ -258,6 +271,9 @@ func resourceComputeNetworkRead(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 	}
+	if err := d.Set("mtu", flattenComputeNetworkMtu(res["mtu"], d)); err != nil {
+		return fmt.Errorf("Error reading Network: %s", err)
+	}
 	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
 		return fmt.Errorf("Error reading Network: %s", err)
 	}
@@ -407,6 +423,16 @@ func flattenComputeNetworkRoutingConfigRoutingMode(v interface{}, d *schema.Reso
 	return v
 }
 
+func flattenComputeNetworkMtu(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
 func expandComputeNetworkDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -439,6 +465,10 @@ func expandComputeNetworkRoutingConfigRoutingMode(v interface{}, d TerraformReso
 	return v, nil
 }
 
+func expandComputeNetworkMtu(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func resourceComputeNetworkEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
 	if _, ok := d.GetOk("ipv4_range"); !ok {
 		obj["autoCreateSubnetworks"] = d.Get("auto_create_subnetworks")
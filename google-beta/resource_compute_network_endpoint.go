@@ -111,7 +111,9 @@ func resourceComputeNetworkEndpointCreate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{network_endpoint_group}}/attachNetworkEndpoints")
@@ -215,7 +217,9 @@ func resourceComputeNetworkEndpointDelete(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{network_endpoint_group}}/detachNetworkEndpoints")
@@ -17,6 +17,7 @@ func resourceComputeNetworkPeering() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeNetworkPeeringCreate,
 		Read:   resourceComputeNetworkPeeringRead,
+		Update: resourceComputeNetworkPeeringUpdate,
 		Delete: resourceComputeNetworkPeeringDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -59,13 +60,21 @@ func resourceComputeNetworkPeering() *schema.Resource {
 			},
 			"export_custom_routes": {
 				Type:     schema.TypeBool,
-				ForceNew: true,
 				Optional: true,
 				Default:  false,
 			},
 			"import_custom_routes": {
 				Type:     schema.TypeBool,
-				ForceNew: true,
+				Optional: true,
+				Default:  false,
+			},
+			"export_subnet_routes_with_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"import_subnet_routes_with_public_ip": {
+				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
@@ -123,12 +132,51 @@ func resourceComputeNetworkPeeringRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", peering.Name)
 	d.Set("import_custom_routes", peering.ImportCustomRoutes)
 	d.Set("export_custom_routes", peering.ExportCustomRoutes)
+	d.Set("import_subnet_routes_with_public_ip", peering.ImportSubnetRoutesWithPublicIp)
+	d.Set("export_subnet_routes_with_public_ip", peering.ExportSubnetRoutesWithPublicIp)
 	d.Set("state", peering.State)
 	d.Set("state_details", peering.StateDetails)
 
 	return nil
 }
 
+func resourceComputeNetworkPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	networkFieldValue, err := ParseNetworkFieldValue(d.Get("network").(string), d, config)
+	if err != nil {
+		return err
+	}
+	peerNetworkFieldValue, err := ParseNetworkFieldValue(d.Get("peer_network").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	// Route exchange settings are updated in place so the peering, and the
+	// traffic flowing over it, isn't torn down.
+	request := &computeBeta.NetworksUpdatePeeringRequest{}
+	request.NetworkPeering = expandNetworkPeering(d)
+
+	// Peering operations can't run concurrently on any of the peered VPCs.
+	peeringLockName := getNetworkPeeringLockName(networkFieldValue.Name, peerNetworkFieldValue.Name)
+	if err := mutexKV.Lock(peeringLockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(peeringLockName)
+
+	updateOp, err := config.clientComputeBeta.Networks.UpdatePeering(networkFieldValue.Project, networkFieldValue.Name, request).Do()
+	if err != nil {
+		return fmt.Errorf("Error updating network peering: %s", err)
+	}
+
+	err = computeSharedOperationWait(config.clientCompute, updateOp, networkFieldValue.Project, "Updating Network Peering")
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeNetworkPeeringRead(d, meta)
+}
+
 func resourceComputeNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -149,7 +197,9 @@ func resourceComputeNetworkPeeringDelete(d *schema.ResourceData, meta interface{
 
 	// Only one delete peering operation at a time can be performed inside any peered VPCs.
 	peeringLockName := getNetworkPeeringLockName(networkFieldValue.Name, peerNetworkFieldValue.Name)
-	mutexKV.Lock(peeringLockName)
+	if err := mutexKV.Lock(peeringLockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(peeringLockName)
 
 	removeOp, err := config.clientCompute.Networks.RemovePeering(networkFieldValue.Project, networkFieldValue.Name, request).Do()
@@ -182,9 +232,14 @@ func expandNetworkPeering(d *schema.ResourceData) *computeBeta.NetworkPeering {
 		ExportCustomRoutes: d.Get("export_custom_routes").(bool),
 		ImportCustomRoutes: d.Get("import_custom_routes").(bool),
 		// auto_create_routes was replaced by exchange_subnet_routes in the network peering object
-		ExchangeSubnetRoutes: true,
-		Name:                 d.Get("name").(string),
-		Network:              d.Get("peer_network").(string),
+		ExchangeSubnetRoutes:           true,
+		ExportSubnetRoutesWithPublicIp: d.Get("export_subnet_routes_with_public_ip").(bool),
+		ImportSubnetRoutesWithPublicIp: d.Get("import_subnet_routes_with_public_ip").(bool),
+		Name:                           d.Get("name").(string),
+		Network:                        d.Get("peer_network").(string),
+		// Send false values explicitly; the API defaults export_subnet_routes_with_public_ip
+		// to true, and an update has to be able to turn any of these off.
+		ForceSendFields: []string{"ExportCustomRoutes", "ImportCustomRoutes", "ExportSubnetRoutesWithPublicIp", "ImportSubnetRoutesWithPublicIp"},
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	computeBeta "google.golang.org/api/compute/v0.beta"
 )
@@ -38,6 +39,63 @@ func TestAccComputeNetworkPeering_basic(t *testing.T) {
 
 }
 
+func TestAccComputeNetworkPeering_routeExchangeUpdate(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	resourceName := "google_compute_network_peering.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccComputeNetworkPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNetworkPeering_routeExchange(suffix, false, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "export_custom_routes", "false"),
+					resource.TestCheckResourceAttr(resourceName, "export_subnet_routes_with_public_ip", "true"),
+					resource.TestCheckResourceAttr(resourceName, "import_subnet_routes_with_public_ip", "false"),
+				),
+			},
+			{
+				Config: testAccComputeNetworkPeering_routeExchange(suffix, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "export_custom_routes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "export_subnet_routes_with_public_ip", "false"),
+					resource.TestCheckResourceAttr(resourceName, "import_subnet_routes_with_public_ip", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandNetworkPeering_subnetRoutesWithPublicIp(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceComputeNetworkPeering().Schema, map[string]interface{}{
+		"name":                                "foo",
+		"network":                             "projects/my-project/global/networks/foo",
+		"peer_network":                        "projects/my-project/global/networks/bar",
+		"export_subnet_routes_with_public_ip": false,
+		"import_subnet_routes_with_public_ip": true,
+	})
+
+	peering := expandNetworkPeering(d)
+	if peering.ExportSubnetRoutesWithPublicIp || !peering.ImportSubnetRoutesWithPublicIp {
+		t.Fatalf("unexpected subnet route fields: export=%t import=%t", peering.ExportSubnetRoutesWithPublicIp, peering.ImportSubnetRoutesWithPublicIp)
+	}
+
+	// The API defaults export to true, so false has to be sent explicitly.
+	b, err := peering.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"exportSubnetRoutesWithPublicIp":false`, `"importSubnetRoutesWithPublicIp":true`} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("expected %s in request body, got %s", want, b)
+		}
+	}
+}
+
 func testAccComputeNetworkPeeringDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -153,3 +211,27 @@ func testAccComputeNetworkPeering_basic() string {
 	s = s + `}`
 	return fmt.Sprintf(s, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
 }
+
+func testAccComputeNetworkPeering_routeExchange(suffix string, exportCustomRoutes, exportSubnetRoutes, importSubnetRoutes bool) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "network1" {
+	name                    = "network-test-1-%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_network" "network2" {
+	name                    = "network-test-2-%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_network_peering" "foo" {
+	name         = "peering-test-1-%s"
+	network      = "${google_compute_network.network1.self_link}"
+	peer_network = "${google_compute_network.network2.self_link}"
+
+	export_custom_routes                = %t
+	export_subnet_routes_with_public_ip = %t
+	import_subnet_routes_with_public_ip = %t
+}
+`, suffix, suffix, suffix, exportCustomRoutes, exportSubnetRoutes, importSubnetRoutes)
+}
@@ -165,6 +165,32 @@ func TestAccComputeNetwork_networkDeleteDefaultRoute(t *testing.T) {
 	})
 }
 
+func TestAccComputeNetwork_mtu(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNetwork_mtu(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_network.acc_network_mtu", "mtu", "1500"),
+				),
+			},
+			{
+				ResourceName:            "google_compute_network.acc_network_mtu",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_default_routes_on_create"},
+			},
+		},
+	})
+}
+
 func testAccCheckComputeNetworkExists(n string, network *compute.Network) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -308,3 +334,12 @@ resource "google_compute_network" "bar" {
 	auto_create_subnetworks = false
 }`, acctest.RandString(10))
 }
+
+func testAccComputeNetwork_mtu(network string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "acc_network_mtu" {
+	name                    = "network-test-%s"
+	auto_create_subnetworks = false
+	mtu                     = 1500
+}`, network)
+}
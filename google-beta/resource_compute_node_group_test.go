@@ -50,6 +50,53 @@ func TestAccComputeNodeGroup_updateNodeTemplate(t *testing.T) {
 	})
 }
 
+func TestAccComputeNodeGroup_autoscalingPolicy(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("group-")
+	tmplPrefix := acctest.RandomWithPrefix("tmpl-")
+
+	var timeCreated time.Time
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNodeGroup_autoscalingPolicy(groupName, tmplPrefix, "ONLY_SCALE_OUT", 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNodeGroupCreationTimeBefore(&timeCreated),
+				),
+			},
+			{
+				ResourceName:            "google_compute_node_group.nodes",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_size"},
+			},
+			{
+				Config: testAccComputeNodeGroup_autoscalingPolicy(groupName, tmplPrefix, "ON", 0, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNodeGroupCreationTimeBefore(&timeCreated),
+				),
+			},
+			{
+				ResourceName:            "google_compute_node_group.nodes",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_size"},
+			},
+			{
+				// Turning the autoscaler off keeps whatever size it left the group at.
+				Config: testAccComputeNodeGroup_autoscalingPolicy(groupName, tmplPrefix, "OFF", 0, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNodeGroupCreationTimeBefore(&timeCreated),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeNodeGroupCreationTimeBefore(prevTimeCreated *time.Time) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for name, rs := range s.RootModule().Resources {
@@ -113,3 +160,33 @@ resource "google_compute_node_group" "nodes" {
 }
 `, tmplPrefix, tmplPrefix, groupName, tmplToUse)
 }
+
+func testAccComputeNodeGroup_autoscalingPolicy(groupName, tmplPrefix, mode string, minNodes, maxNodes int) string {
+	return fmt.Sprintf(`
+data "google_compute_node_types" "central1a" {
+  zone = "us-central1-a"
+}
+
+resource "google_compute_node_template" "tmpl" {
+  name = "%s"
+  region = "us-central1"
+  node_type = "${data.google_compute_node_types.central1a.names[0]}"
+}
+
+resource "google_compute_node_group" "nodes" {
+  name = "%s"
+  zone = "us-central1-a"
+  description = "example google_compute_node_group for Terraform Google Provider"
+  maintenance_policy = "RESTART_IN_PLACE"
+
+  initial_size = 1
+  node_template = "${google_compute_node_template.tmpl.self_link}"
+
+  autoscaling_policy {
+    mode      = "%s"
+    min_nodes = %d
+    max_nodes = %d
+  }
+}
+`, tmplPrefix, groupName, mode, minNodes, maxNodes)
+}
@@ -0,0 +1,110 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputePacketMirroring_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputePacketMirroringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputePacketMirroring_full(suffix, "tcp", "INGRESS", 1000),
+			},
+			{
+				ResourceName:      "google_compute_packet_mirroring.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputePacketMirroring_full(suffix, "udp", "EGRESS", 1100),
+			},
+			{
+				ResourceName:      "google_compute_packet_mirroring.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputePacketMirroring_full(suffix, protocol, direction string, priority int) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "default" {
+  name = "tf-test-network-%s"
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-subnetwork-%s"
+  network       = "${google_compute_network.default.self_link}"
+  ip_cidr_range = "10.2.0.0/16"
+}
+
+resource "google_compute_health_check" "default" {
+  name               = "tf-test-healthcheck-%s"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  tcp_health_check {
+    port = "80"
+  }
+}
+
+resource "google_compute_region_backend_service" "default" {
+  name          = "tf-test-service-%s"
+  health_checks = ["${google_compute_health_check.default.self_link}"]
+}
+
+resource "google_compute_forwarding_rule" "default" {
+  depends_on = ["google_compute_subnetwork.default"]
+  name       = "tf-test-ilb-%s"
+
+  is_mirroring_collector = true
+  ip_protocol            = "TCP"
+  load_balancing_scheme  = "INTERNAL"
+  backend_service        = "${google_compute_region_backend_service.default.self_link}"
+  all_ports              = true
+  network                = "${google_compute_network.default.self_link}"
+  subnetwork             = "${google_compute_subnetwork.default.self_link}"
+  network_tier           = "PREMIUM"
+}
+
+resource "google_compute_packet_mirroring" "foobar" {
+  name        = "tf-test-mirroring-%s"
+  description = "Resource created for Terraform acceptance testing"
+  priority    = %d
+
+  network {
+    url = "${google_compute_network.default.self_link}"
+  }
+
+  collector_ilb {
+    url = "${google_compute_forwarding_rule.default.self_link}"
+  }
+
+  mirrored_resources {
+    tags = ["foo"]
+
+    subnetworks {
+      url = "${google_compute_subnetwork.default.self_link}"
+    }
+  }
+
+  filter {
+    ip_protocols = ["%s"]
+    cidr_ranges  = ["0.0.0.0/0"]
+    direction    = "%s"
+  }
+}
+`, suffix, suffix, suffix, suffix, suffix, suffix, priority, protocol, direction)
+}
@@ -0,0 +1,624 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputePerInstanceConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputePerInstanceConfigCreate,
+		Read:   resourceComputePerInstanceConfigRead,
+		Update: resourceComputePerInstanceConfigUpdate,
+		Delete: resourceComputePerInstanceConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputePerInstanceConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_group_manager": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"preserved_state": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"source": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: compareSelfLinkRelativePaths,
+									},
+									"delete_rule": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION", ""}, false),
+										Default:      "NEVER",
+									},
+									"mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"READ_ONLY", "READ_WRITE", ""}, false),
+										Default:      "READ_WRITE",
+									},
+								},
+							},
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"minimal_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"REPLACE", "RESTART", "REFRESH", "NONE"}, false),
+				Default:      "NONE",
+			},
+			"most_disruptive_allowed_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"REPLACE", "RESTART", "REFRESH", "NONE"}, false),
+				Default:      "REPLACE",
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputePerInstanceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputePerInstanceConfigName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	preservedStateProp, err := expandComputePerInstanceConfigPreservedState(d.Get("preserved_state"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("preserved_state"); !isEmptyValue(reflect.ValueOf(preservedStateProp)) && (ok || !reflect.DeepEqual(v, preservedStateProp)) {
+		obj["preservedState"] = preservedStateProp
+	}
+
+	obj, err = resourceComputePerInstanceConfigEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	lockName, err := replaceVars(d, config, "instanceGroupManager/{{project}}/{{zone}}/{{instance_group_manager}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/instanceGroupManagers/{{instance_group_manager}}/createInstances")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new PerInstanceConfig: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating PerInstanceConfig: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{instance_group_manager}}/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config.clientCompute, op, project, "Creating PerInstanceConfig",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create PerInstanceConfig: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating PerInstanceConfig %q: %#v", d.Id(), res)
+
+	return resourceComputePerInstanceConfigRead(d, meta)
+}
+
+func resourceComputePerInstanceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/instanceGroupManagers/{{instance_group_manager}}/listPerInstanceConfigs")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "POST", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputePerInstanceConfig %q", d.Id()))
+	}
+
+	res, err = flattenNestedComputePerInstanceConfig(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	if res == nil {
+		// Object isn't there any more - remove it from the state.
+		log.Printf("[DEBUG] Removing ComputePerInstanceConfig because it couldn't be matched.")
+		d.SetId("")
+		return nil
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading PerInstanceConfig: %s", err)
+	}
+
+	if err := d.Set("name", flattenComputePerInstanceConfigName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading PerInstanceConfig: %s", err)
+	}
+	if err := d.Set("preserved_state", flattenComputePerInstanceConfigPreservedState(res["preservedState"], d)); err != nil {
+		return fmt.Errorf("Error reading PerInstanceConfig: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputePerInstanceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputePerInstanceConfigName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	preservedStateProp, err := expandComputePerInstanceConfigPreservedState(d.Get("preserved_state"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("preserved_state"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, preservedStateProp)) {
+		obj["preservedState"] = preservedStateProp
+	}
+
+	obj, err = resourceComputePerInstanceConfigUpdateEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	lockName, err := replaceVars(d, config, "instanceGroupManager/{{project}}/{{zone}}/{{instance_group_manager}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/instanceGroupManagers/{{instance_group_manager}}/updatePerInstanceConfigs")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating PerInstanceConfig %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating PerInstanceConfig %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Updating PerInstanceConfig",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	// Instances only pick up their new preserved state once updates are applied to them.
+	instanceName, err := replaceVars(d, config, "zones/{{zone}}/instances/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	obj = make(map[string]interface{})
+	obj["instances"] = []string{instanceName}
+	obj["minimalAction"] = d.Get("minimal_action")
+	obj["mostDisruptiveAllowedAction"] = d.Get("most_disruptive_allowed_action")
+
+	url, err = replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/instanceGroupManagers/{{instance_group_manager}}/applyUpdatesToInstances")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Applying updates to PerInstanceConfig %q: %#v", d.Id(), obj)
+	res, err = sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error applying updates to PerInstanceConfig %q: %s", d.Id(), err)
+	}
+
+	op = &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Applying updates to PerInstanceConfig",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputePerInstanceConfigRead(d, meta)
+}
+
+func resourceComputePerInstanceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	lockName, err := replaceVars(d, config, "instanceGroupManager/{{project}}/{{zone}}/{{instance_group_manager}}")
+	if err != nil {
+		return err
+	}
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/instanceGroupManagers/{{instance_group_manager}}/deletePerInstanceConfigs")
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"names": []string{d.Get("name").(string)},
+	}
+	log.Printf("[DEBUG] Deleting PerInstanceConfig %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "PerInstanceConfig")
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Deleting PerInstanceConfig",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	// The instance was created along with its config, so remove it from the group as well.
+	instanceName, err := replaceVars(d, config, "zones/{{zone}}/instances/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	obj = map[string]interface{}{
+		"instances": []string{instanceName},
+	}
+
+	url, err = replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/instanceGroupManagers/{{instance_group_manager}}/deleteInstances")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting instance for PerInstanceConfig %q", d.Id())
+	res, err = sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "PerInstanceConfig")
+	}
+
+	op = &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Deleting instance for PerInstanceConfig",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting PerInstanceConfig %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputePerInstanceConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroupManagers/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)", "(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)", "(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{instance_group_manager}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Import-only fields
+	d.Set("minimal_action", "NONE")
+	d.Set("most_disruptive_allowed_action", "REPLACE")
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputePerInstanceConfigName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputePerInstanceConfigPreservedState(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["metadata"] =
+		flattenComputePerInstanceConfigPreservedStateMetadata(original["metadata"], d)
+	transformed["disk"] =
+		flattenComputePerInstanceConfigPreservedStateDisk(original["disks"], d)
+	return []interface{}{transformed}
+}
+func flattenComputePerInstanceConfigPreservedStateMetadata(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputePerInstanceConfigPreservedStateDisk(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	disks := v.(map[string]interface{})
+	transformed := make([]interface{}, 0, len(disks))
+	for devName, raw := range disks {
+		diskObj := raw.(map[string]interface{})
+		source, err := getRelativePath(diskObj["source"].(string))
+		if err != nil {
+			source = diskObj["source"].(string)
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"device_name": devName,
+			"delete_rule": diskObj["autoDelete"],
+			"source":      source,
+			"mode":        diskObj["mode"],
+		})
+	}
+	return transformed
+}
+
+func expandComputePerInstanceConfigName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputePerInstanceConfigPreservedState(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMetadata, err := expandComputePerInstanceConfigPreservedStateMetadata(original["metadata"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMetadata); val.IsValid() && !isEmptyValue(val) {
+		transformed["metadata"] = transformedMetadata
+	}
+
+	transformedDisk, err := expandComputePerInstanceConfigPreservedStateDisk(original["disk"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDisk); val.IsValid() && !isEmptyValue(val) {
+		transformed["disks"] = transformedDisk
+	}
+
+	return transformed, nil
+}
+
+func expandComputePerInstanceConfigPreservedStateMetadata(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandComputePerInstanceConfigPreservedStateDisk(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == nil {
+		return map[string]interface{}{}, nil
+	}
+	l := v.(*schema.Set).List()
+	req := make(map[string]interface{})
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		deviceName := original["device_name"].(string)
+		diskObj := make(map[string]interface{})
+		deleteRule := original["delete_rule"].(string)
+		if deleteRule != "" {
+			diskObj["autoDelete"] = deleteRule
+		}
+		source := original["source"]
+		if source != "" {
+			diskObj["source"] = source
+		}
+		mode := original["mode"]
+		if mode != "" {
+			diskObj["mode"] = mode
+		}
+		req[deviceName] = diskObj
+	}
+	return req, nil
+}
+
+func resourceComputePerInstanceConfigEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	wrappedReq := map[string]interface{}{
+		"instances": []interface{}{obj},
+	}
+	return wrappedReq, nil
+}
+
+func resourceComputePerInstanceConfigUpdateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// updates and creates use different wrapping object names
+	wrappedReq := map[string]interface{}{
+		"perInstanceConfigs": []interface{}{obj},
+	}
+	return wrappedReq, nil
+}
+
+func flattenNestedComputePerInstanceConfig(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	var v interface{}
+	var ok bool
+
+	v, ok = res["items"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	switch v.(type) {
+	case []interface{}:
+		break
+	case map[string]interface{}:
+		// Construct list out of single nested resource
+		v = []interface{}{v}
+	default:
+		return nil, fmt.Errorf("expected list or map for value items. Actual value: %v", v)
+	}
+
+	expectedName, err := expandComputePerInstanceConfigName(d.Get("name"), d, meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+
+	// Search list for this resource.
+	items := v.([]interface{})
+	for _, itemRaw := range items {
+		if itemRaw == nil {
+			continue
+		}
+		item := itemRaw.(map[string]interface{})
+
+		itemName := flattenComputePerInstanceConfigName(item["name"], d)
+		if !reflect.DeepEqual(itemName, expectedName) {
+			log.Printf("[DEBUG] Skipping item with name= %#v, looking for %#v)", itemName, expectedName)
+			continue
+		}
+		log.Printf("[DEBUG] Found item for resource %q: %#v)", d.Id(), item)
+		return item, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,186 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputePerInstanceConfig_statefulBasic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	igmName := fmt.Sprintf("igm-test-%s", suffix)
+	context := map[string]interface{}{
+		"igm_name":      igmName,
+		"random_suffix": suffix,
+		"config_name":   fmt.Sprintf("instance-%s", acctest.RandString(10)),
+	}
+	igmId := fmt.Sprintf("projects/%s/zones/us-central1-c/instanceGroupManagers/%s",
+		getTestProjectFromEnv(), igmName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Create one config
+				Config: testAccComputePerInstanceConfig_statefulBasic(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputePerInstanceConfigExists(igmId, context["config_name"].(string)),
+				),
+			},
+			{
+				ResourceName:      "google_compute_per_instance_config.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Update the preserved state of the existing config
+				Config: testAccComputePerInstanceConfig_statefulModified(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputePerInstanceConfigExists(igmId, context["config_name"].(string)),
+				),
+			},
+			{
+				ResourceName:      "google_compute_per_instance_config.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Delete the config
+				Config: testAccComputePerInstanceConfig_igm(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputePerInstanceConfigDestroyed(igmId, context["config_name"].(string)),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputePerInstanceConfig_statefulBasic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_per_instance_config" "default" {
+  zone                   = "us-central1-c"
+  instance_group_manager = "${google_compute_instance_group_manager.igm.name}"
+  name                   = "%{config_name}"
+  preserved_state {
+    metadata = {
+      asdf = "asdf"
+    }
+  }
+}
+`, context) + testAccComputePerInstanceConfig_igm(context)
+}
+
+func testAccComputePerInstanceConfig_statefulModified(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_per_instance_config" "default" {
+  zone                   = "us-central1-c"
+  instance_group_manager = "${google_compute_instance_group_manager.igm.name}"
+  name                   = "%{config_name}"
+  preserved_state {
+    metadata = {
+      asdf = "foo"
+      foo  = "bar"
+    }
+  }
+}
+`, context) + testAccComputePerInstanceConfig_igm(context)
+}
+
+func testAccComputePerInstanceConfig_igm(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-9"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "igm-basic" {
+  name           = "igm-temp-%{random_suffix}"
+  machine_type   = "n1-standard-1"
+  can_ip_forward = false
+  tags           = ["foo", "bar"]
+
+  disk {
+    source_image = "${data.google_compute_image.my_image.self_link}"
+    auto_delete  = true
+    boot         = true
+    device_name  = "my-stateful-disk"
+  }
+
+  network_interface {
+    network = "default"
+  }
+
+  service_account {
+    scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+  }
+}
+
+resource "google_compute_instance_group_manager" "igm" {
+  description = "Terraform test instance group manager"
+  name        = "%{igm_name}"
+
+  version {
+    name              = "prod"
+    instance_template = "${google_compute_instance_template.igm-basic.self_link}"
+  }
+
+  base_instance_name = "igm-no-tp"
+  zone               = "us-central1-c"
+}
+`, context)
+}
+
+func testAccCheckComputePerInstanceConfigExists(igmId, configName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		foundNames, err := testAccCheckComputePerInstanceConfigListNames(igmId)
+		if err != nil {
+			return fmt.Errorf("unable to confirm config with name %s exists: %v", configName, err)
+		}
+		if _, ok := foundNames[configName]; !ok {
+			return fmt.Errorf("unable to find per instance config with name %s", configName)
+		}
+		return nil
+	}
+}
+
+func testAccCheckComputePerInstanceConfigDestroyed(igmId, configName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		foundNames, err := testAccCheckComputePerInstanceConfigListNames(igmId)
+		if err != nil {
+			return fmt.Errorf("unable to confirm config with name %s was destroyed: %v", configName, err)
+		}
+		if _, ok := foundNames[configName]; ok {
+			return fmt.Errorf("config with name %s still exists", configName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputePerInstanceConfigListNames(igmId string) (map[string]struct{}, error) {
+	config := testAccProvider.Meta().(*Config)
+
+	url := fmt.Sprintf("https://www.googleapis.com/compute/beta/%s/listPerInstanceConfigs", igmId)
+	res, err := sendRequest(config, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := res["items"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	items := v.([]interface{})
+	instanceConfigs := make(map[string]struct{})
+	for _, item := range items {
+		perInstanceConfig := item.(map[string]interface{})
+		instanceConfigs[fmt.Sprintf("%v", perInstanceConfig["name"])] = struct{}{}
+	}
+	return instanceConfigs, nil
+}
@@ -154,6 +154,71 @@ func TestAccComputeRegionBackendService_withConnectionDrainingAndUpdate(t *testi
 	}
 }
 
+func TestAccComputeRegionBackendService_withBackendInternalManaged(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	igName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	itName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionBackendService_withBackendInternalManaged(
+					serviceName, igName, itName, checkName, "UTILIZATION"),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionBackendService_withBackendInternalManaged(
+					serviceName, igName, itName, checkName, "RATE"),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeRegionBackendService_ilbUpdateFull(t *testing.T) {
+	t.Parallel()
+
+	randString := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionBackendService_ilbBasic(randString),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionBackendService_ilbFull(randString),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeRegionBackendServiceExists(n string, svc *compute.BackendService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -318,3 +383,163 @@ resource "google_compute_health_check" "zero" {
 }
 `, serviceName, drainingTimeout, checkName)
 }
+
+func testAccComputeRegionBackendService_withBackendInternalManaged(
+	serviceName, igName, itName, checkName, balancingMode string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-9"
+  project = "debian-cloud"
+}
+
+resource "google_compute_region_backend_service" "default" {
+  name                  = "%s"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  protocol              = "HTTP"
+  region                = "us-central1"
+
+  backend {
+    group                 = "${google_compute_instance_group_manager.foobar.instance_group}"
+    balancing_mode        = "%s"
+    capacity_scaler       = 1.0
+    max_rate_per_instance = 100
+    max_utilization       = 0.8
+  }
+
+  health_checks = ["${google_compute_region_health_check.default.self_link}"]
+}
+
+resource "google_compute_instance_group_manager" "foobar" {
+  name               = "%s"
+  version {
+    instance_template  = "${google_compute_instance_template.foobar.self_link}"
+    name               = "primary"
+  }
+  base_instance_name = "foobar"
+  zone               = "us-central1-f"
+  target_size        = 1
+}
+
+resource "google_compute_instance_template" "foobar" {
+  name         = "%s"
+  machine_type = "n1-standard-1"
+
+  network_interface {
+    network = "default"
+  }
+
+  disk {
+    source_image = "${data.google_compute_image.my_image.self_link}"
+    auto_delete  = true
+    boot         = true
+  }
+}
+
+resource "google_compute_region_health_check" "default" {
+  name   = "%s"
+  region = "us-central1"
+
+  http_health_check {
+    port = 80
+  }
+}
+`, serviceName, balancingMode, igName, itName, checkName)
+}
+
+func testAccComputeRegionBackendService_ilbBasic(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_backend_service" "foobar" {
+  name                  = "tf-test-region-service-%s"
+  region                = "us-central1"
+  health_checks         = ["${google_compute_region_health_check.health_check.self_link}"]
+  protocol              = "HTTP"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  locality_lb_policy    = "RING_HASH"
+  session_affinity      = "HTTP_COOKIE"
+
+  circuit_breakers {
+    max_connections = 10
+  }
+
+  consistent_hash {
+    http_cookie {
+      ttl {
+        seconds = 11
+        nanos   = 1234
+      }
+      name = "mycookie"
+    }
+  }
+
+  outlier_detection {
+    consecutive_errors = 2
+  }
+}
+
+resource "google_compute_region_health_check" "health_check" {
+  name   = "tf-test-health-check-%s"
+  region = "us-central1"
+
+  http_health_check {
+    port = 80
+  }
+}
+`, randString, randString)
+}
+
+func testAccComputeRegionBackendService_ilbFull(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_backend_service" "foobar" {
+  name                  = "tf-test-region-service-%s"
+  region                = "us-central1"
+  health_checks         = ["${google_compute_region_health_check.health_check.self_link}"]
+  protocol              = "HTTP"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  locality_lb_policy    = "RANDOM"
+
+  circuit_breakers {
+    connect_timeout {
+      seconds = 3
+    }
+    max_requests_per_connection = 4
+    max_connections             = 5
+    max_pending_requests        = 6
+    max_requests                = 7
+    max_retries                 = 8
+  }
+
+  outlier_detection {
+    base_ejection_time {
+      seconds = 0
+      nanos   = 5
+    }
+    consecutive_errors                    = 1
+    consecutive_gateway_failure           = 3
+    enforcing_consecutive_errors          = 4
+    enforcing_consecutive_gateway_failure = 5
+    enforcing_success_rate                = 6
+    interval {
+      seconds = 7
+    }
+    max_ejection_percent        = 99
+    success_rate_minimum_hosts  = 98
+    success_rate_request_volume = 97
+    success_rate_stdev_factor   = 1800
+  }
+
+  log_config {
+    enable      = true
+    sample_rate = 0.5
+  }
+}
+
+resource "google_compute_region_health_check" "health_check" {
+  name   = "tf-test-health-check-%s"
+  region = "us-central1"
+
+  http_health_check {
+    port = 80
+  }
+}
+`, randString, randString)
+}
@@ -0,0 +1,91 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeRegionSslCertificate_regionSslCertificateBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionSslCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionSslCertificate_regionSslCertificateBasicExample(context),
+			},
+			{
+				ResourceName:            "google_compute_region_ssl_certificate.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key", "name_prefix"},
+			},
+		},
+	})
+}
+
+func testAccComputeRegionSslCertificate_regionSslCertificateBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_region_ssl_certificate" "default" {
+  region      = "us-central1"
+  name_prefix = "my-certificate-"
+  description = "a description"
+  private_key = "${file("test-fixtures/ssl_cert/test.key")}"
+  certificate = "${file("test-fixtures/ssl_cert/test.crt")}"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, context)
+}
+
+func testAccCheckComputeRegionSslCertificateDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_region_ssl_certificate" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/projects/{{project}}/regions/{{region}}/sslCertificates/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeRegionSslCertificate still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeRegionTargetHttpsProxy_regionTargetHttpsProxyBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionTargetHttpsProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionTargetHttpsProxy_regionTargetHttpsProxyBasicExample(context),
+			},
+			{
+				ResourceName:      "google_compute_region_target_https_proxy.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeRegionTargetHttpsProxy_regionTargetHttpsProxyBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_region_target_https_proxy" "default" {
+  region           = "us-central1"
+  name             = "test-proxy-%{random_suffix}"
+  url_map          = "${google_compute_region_url_map.default.self_link}"
+  ssl_certificates = ["${google_compute_region_ssl_certificate.default.self_link}"]
+}
+
+resource "google_compute_region_ssl_certificate" "default" {
+  region      = "us-central1"
+  name        = "my-certificate-%{random_suffix}"
+  private_key = "${file("test-fixtures/ssl_cert/test.key")}"
+  certificate = "${file("test-fixtures/ssl_cert/test.crt")}"
+}
+
+resource "google_compute_region_url_map" "default" {
+  region          = "us-central1"
+  name            = "url-map-%{random_suffix}"
+  default_service = "${google_compute_region_backend_service.default.self_link}"
+
+  host_rule {
+    hosts        = ["mysite.com"]
+    path_matcher = "allpaths"
+  }
+
+  path_matcher {
+    name            = "allpaths"
+    default_service = "${google_compute_region_backend_service.default.self_link}"
+
+    path_rule {
+      paths   = ["/*"]
+      service = "${google_compute_region_backend_service.default.self_link}"
+    }
+  }
+}
+
+resource "google_compute_region_backend_service" "default" {
+  region      = "us-central1"
+  name        = "region-backend-service-%{random_suffix}"
+  protocol    = "HTTP"
+  timeout_sec = 10
+
+  load_balancing_scheme = "INTERNAL_MANAGED"
+
+  health_checks = ["${google_compute_region_health_check.default.self_link}"]
+}
+
+resource "google_compute_region_health_check" "default" {
+  region = "us-central1"
+  name   = "health-check-%{random_suffix}"
+
+  http_health_check {
+    port = 80
+  }
+}
+`, context)
+}
+
+func testAccCheckComputeRegionTargetHttpsProxyDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_region_target_https_proxy" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/projects/{{project}}/regions/{{region}}/targetHttpsProxies/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeRegionTargetHttpsProxy still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeReservation_update(t *testing.T) {
+	t.Parallel()
+
+	reservationName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeReservation_basic(reservationName, "2"),
+			},
+			{
+				ResourceName:      "google_compute_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeReservation_basic(reservationName, "1"),
+			},
+			{
+				ResourceName:      "google_compute_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeReservation_guestAcceleratorsAndLocalSsds(t *testing.T) {
+	t.Parallel()
+
+	reservationName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeReservation_guestAcceleratorsAndLocalSsds(reservationName),
+			},
+			{
+				ResourceName:      "google_compute_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeReservation_basic(reservationName, count string) string {
+	return fmt.Sprintf(`
+resource "google_compute_reservation" "reservation" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	specific_reservation {
+		count = %s
+		instance_properties {
+			min_cpu_platform = "Intel Cascade Lake"
+			machine_type     = "n2-standard-2"
+		}
+	}
+}
+`, reservationName, count)
+}
+
+func testAccComputeReservation_guestAcceleratorsAndLocalSsds(reservationName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_reservation" "reservation" {
+	name                          = "%s"
+	zone                          = "us-central1-a"
+	specific_reservation_required = true
+
+	specific_reservation {
+		count = 1
+		instance_properties {
+			machine_type = "n1-standard-1"
+
+			guest_accelerators {
+				accelerator_type  = "nvidia-tesla-k80"
+				accelerator_count = 1
+			}
+
+			local_ssds {
+				interface    = "NVME"
+				disk_size_gb = 375
+			}
+		}
+	}
+}
+`, reservationName)
+}
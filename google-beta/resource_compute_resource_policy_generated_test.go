@@ -119,6 +119,77 @@ resource "google_compute_resource_policy" "bar" {
 `, context)
 }
 
+func TestAccComputeResourcePolicy_resourcePolicyPlacementPolicyExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersOiCS,
+		CheckDestroy: testAccCheckComputeResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeResourcePolicy_resourcePolicyPlacementPolicyExample(context),
+			},
+		},
+	})
+}
+
+func testAccComputeResourcePolicy_resourcePolicyPlacementPolicyExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_resource_policy" "baz" {
+  provider = "google-beta"
+  name   = "policy-%{random_suffix}"
+  region = "us-central1"
+  group_placement_policy {
+    vm_count = 2
+    collocation = "COLLOCATED"
+  }
+}
+`, context)
+}
+
+func TestAccComputeResourcePolicy_resourcePolicyInstanceSchedulePolicyExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersOiCS,
+		CheckDestroy: testAccCheckComputeResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeResourcePolicy_resourcePolicyInstanceSchedulePolicyExample(context),
+			},
+		},
+	})
+}
+
+func testAccComputeResourcePolicy_resourcePolicyInstanceSchedulePolicyExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_resource_policy" "hourly" {
+  provider = "google-beta"
+  name   = "policy-%{random_suffix}"
+  region = "us-central1"
+  instance_schedule_policy {
+    vm_start_schedule {
+      schedule = "0 * * * *"
+    }
+    vm_stop_schedule {
+      schedule = "15 * * * *"
+    }
+    time_zone = "US/Central"
+  }
+}
+`, context)
+}
+
 func testAccCheckComputeResourcePolicyDestroy(s *terraform.State) error {
 	for name, rs := range s.RootModule().Resources {
 		if rs.Type != "google_compute_resource_policy" {
@@ -166,7 +166,9 @@ func resourceComputeRouterCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/regions/{{region}}/routers")
@@ -279,7 +281,9 @@ func resourceComputeRouterUpdate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/regions/{{region}}/routers/{{name}}")
@@ -322,7 +326,9 @@ func resourceComputeRouterDelete(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/regions/{{region}}/routers/{{name}}")
@@ -87,7 +87,9 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 	ifaceName := d.Get("name").(string)
 
 	routerLock := getRouterLockName(region, routerName)
-	mutexKV.Lock(routerLock)
+	if err := mutexKV.Lock(routerLock); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(routerLock)
 
 	routersService := config.clientCompute.Routers
@@ -220,7 +222,9 @@ func resourceComputeRouterInterfaceDelete(d *schema.ResourceData, meta interface
 	ifaceName := d.Get("name").(string)
 
 	routerLock := getRouterLockName(region, routerName)
-	mutexKV.Lock(routerLock)
+	if err := mutexKV.Lock(routerLock); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(routerLock)
 
 	routersService := config.clientCompute.Routers
@@ -176,7 +176,9 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 	natName := d.Get("name").(string)
 
 	routerLock := getRouterLockName(region, routerName)
-	mutexKV.Lock(routerLock)
+	if err := mutexKV.Lock(routerLock); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(routerLock)
 
 	routersService := config.clientComputeBeta.Routers
@@ -317,7 +319,9 @@ func resourceComputeRouterNatDelete(d *schema.ResourceData, meta interface{}) er
 	natName := d.Get("name").(string)
 
 	routerLock := getRouterLockName(region, routerName)
-	mutexKV.Lock(routerLock)
+	if err := mutexKV.Lock(routerLock); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(routerLock)
 
 	routersService := config.clientComputeBeta.Routers
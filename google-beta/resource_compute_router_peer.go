@@ -95,7 +95,9 @@ func resourceComputeRouterPeerCreate(d *schema.ResourceData, meta interface{}) e
 	peerName := d.Get("name").(string)
 
 	routerLock := getRouterLockName(region, routerName)
-	mutexKV.Lock(routerLock)
+	if err := mutexKV.Lock(routerLock); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(routerLock)
 
 	routersService := config.clientCompute.Routers
@@ -225,7 +227,9 @@ func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) e
 	peerName := d.Get("name").(string)
 
 	routerLock := getRouterLockName(region, routerName)
-	mutexKV.Lock(routerLock)
+	if err := mutexKV.Lock(routerLock); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(routerLock)
 
 	routersService := config.clientCompute.Routers
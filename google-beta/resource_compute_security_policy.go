@@ -57,7 +57,7 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 						"action": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"allow", "deny(403)", "deny(404)", "deny(502)"}, false),
+							ValidateFunc: validation.StringInSlice([]string{"allow", "deny(403)", "deny(404)", "deny(502)", "rate_based_ban", "redirect", "throttle"}, false),
 						},
 
 						"priority": {
@@ -73,7 +73,7 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"config": {
 										Type:     schema.TypeList,
-										Required: true,
+										Optional: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
@@ -90,9 +90,23 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 
 									"versioned_expr": {
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
 										ValidateFunc: validation.StringInSlice([]string{"SRC_IPS_V1"}, false),
 									},
+
+									"expr": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -106,6 +120,72 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+
+						"rate_limit_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rate_limit_threshold": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     securityPolicyRateLimitThresholdSchema(),
+									},
+
+									"conform_action": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"allow"}, false),
+									},
+
+									"exceed_action": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"deny(403)", "deny(404)", "deny(429)", "deny(502)", "redirect"}, false),
+									},
+
+									"exceed_redirect_options": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     securityPolicyRedirectOptionsSchema(),
+									},
+
+									"enforce_on_key": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "ALL",
+										ValidateFunc: validation.StringInSlice([]string{"ALL", "IP", "HTTP_HEADER", "XFF_IP", "HTTP_COOKIE"}, false),
+									},
+
+									"enforce_on_key_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"ban_threshold": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     securityPolicyRateLimitThresholdSchema(),
+									},
+
+									"ban_duration_sec": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"redirect_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     securityPolicyRedirectOptionsSchema(),
+						},
 					},
 				},
 			},
@@ -123,6 +203,39 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 	}
 }
 
+func securityPolicyRateLimitThresholdSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"interval_sec": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func securityPolicyRedirectOptionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EXTERNAL_302", "GOOGLE_RECAPTCHA"}, false),
+			},
+
+			"target": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
 func resourceComputeSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -132,23 +245,31 @@ func resourceComputeSecurityPolicyCreate(d *schema.ResourceData, meta interface{
 	}
 
 	sp := d.Get("name").(string)
-	securityPolicy := &compute.SecurityPolicy{
+	securityPolicy, err := ConvertToMap(&compute.SecurityPolicy{
 		Name:        sp,
 		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return err
 	}
 	if v, ok := d.GetOk("rule"); ok {
-		securityPolicy.Rules = expandSecurityPolicyRules(v.(*schema.Set).List())
+		rules, err := expandSecurityPolicyRules(v.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		securityPolicy["rules"] = rules
 	}
 
 	log.Printf("[DEBUG] SecurityPolicy insert request: %#v", securityPolicy)
 
-	op, err := config.clientComputeBeta.SecurityPolicies.Insert(project, securityPolicy).Do()
+	url := fmt.Sprintf("%sprojects/%s/global/securityPolicies", securityPolicyBasePath, project)
+	op, err := sendSecurityPolicyRequest(config, url, securityPolicy, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return errwrap.Wrapf("Error creating SecurityPolicy: {{err}}", err)
 	}
 
-	d.SetId(securityPolicy.Name)
+	d.SetId(sp)
 
 	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating SecurityPolicy %q", sp))
 	if err != nil {
@@ -166,14 +287,24 @@ func resourceComputeSecurityPolicyRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	securityPolicy, err := config.clientComputeBeta.SecurityPolicies.Get(project, d.Id()).Do()
+	url := fmt.Sprintf("%sprojects/%s/global/securityPolicies/%s", securityPolicyBasePath, project, d.Id())
+	res, err := sendRequest(config, "GET", url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("SecurityPolicy %q", d.Id()))
 	}
 
+	securityPolicy := &compute.SecurityPolicy{}
+	if err := Convert(res, securityPolicy); err != nil {
+		return err
+	}
+	ruleOptions := &securityPolicyRuleOptionsList{}
+	if err := Convert(res, ruleOptions); err != nil {
+		return err
+	}
+
 	d.Set("name", securityPolicy.Name)
 	d.Set("description", securityPolicy.Description)
-	if err := d.Set("rule", flattenSecurityPolicyRules(securityPolicy.Rules)); err != nil {
+	if err := d.Set("rule", flattenSecurityPolicyRules(securityPolicy.Rules, ruleOptions.Rules)); err != nil {
 		return err
 	}
 	d.Set("fingerprint", securityPolicy.Fingerprint)
@@ -227,7 +358,13 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 			nPriorities[priority] = true
 			if !oPriorities[priority] {
 				// If the rule is in new and its priority does not exist in old, then add it.
-				op, err := config.clientComputeBeta.SecurityPolicies.AddRule(project, sp, expandSecurityPolicyRule(rule)).Do()
+				r, err := expandSecurityPolicyRule(rule)
+				if err != nil {
+					return err
+				}
+
+				url := fmt.Sprintf("%sprojects/%s/global/securityPolicies/%s/addRule", securityPolicyBasePath, project, sp)
+				op, err := sendSecurityPolicyRequest(config, url, r, d.Timeout(schema.TimeoutUpdate))
 
 				if err != nil {
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
@@ -239,7 +376,13 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 				}
 			} else if !oSet.Contains(rule) {
 				// If the rule is in new, and its priority is in old, but its hash is different than the one in old, update it.
-				op, err := config.clientComputeBeta.SecurityPolicies.PatchRule(project, sp, expandSecurityPolicyRule(rule)).Priority(priority).Do()
+				r, err := expandSecurityPolicyRule(rule)
+				if err != nil {
+					return err
+				}
+
+				url := fmt.Sprintf("%sprojects/%s/global/securityPolicies/%s/patchRule?priority=%d", securityPolicyBasePath, project, sp, priority)
+				op, err := sendSecurityPolicyRequest(config, url, r, d.Timeout(schema.TimeoutUpdate))
 
 				if err != nil {
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
@@ -296,24 +439,55 @@ func resourceComputeSecurityPolicyDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func expandSecurityPolicyRules(configured []interface{}) []*compute.SecurityPolicyRule {
-	rules := make([]*compute.SecurityPolicyRule, 0, len(configured))
+// sendSecurityPolicyRequest POSTs a security policy or rule and returns the resulting
+// operation. Rules are sent as raw JSON since the vendored compute client doesn't
+// support rate limiting and redirect options yet.
+func sendSecurityPolicyRequest(config *Config, url string, obj map[string]interface{}, timeout time.Duration) (*compute.Operation, error) {
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func expandSecurityPolicyRules(configured []interface{}) ([]interface{}, error) {
+	rules := make([]interface{}, 0, len(configured))
 	for _, raw := range configured {
-		rules = append(rules, expandSecurityPolicyRule(raw))
+		rule, err := expandSecurityPolicyRule(raw)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
 	}
-	return rules
+	return rules, nil
 }
 
-func expandSecurityPolicyRule(raw interface{}) *compute.SecurityPolicyRule {
+func expandSecurityPolicyRule(raw interface{}) (map[string]interface{}, error) {
 	data := raw.(map[string]interface{})
-	return &compute.SecurityPolicyRule{
+	rule, err := ConvertToMap(&compute.SecurityPolicyRule{
 		Description:     data["description"].(string),
 		Priority:        int64(data["priority"].(int)),
 		Action:          data["action"].(string),
 		Preview:         data["preview"].(bool),
 		Match:           expandSecurityPolicyMatch(data["match"].([]interface{})),
 		ForceSendFields: []string{"Description", "Preview"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rateLimitOptions := expandSecurityPolicyRateLimitOptions(data["rate_limit_options"].([]interface{})); rateLimitOptions != nil {
+		rule["rateLimitOptions"] = rateLimitOptions
 	}
+	if redirectOptions := expandSecurityPolicyRedirectOptions(data["redirect_options"].([]interface{})); redirectOptions != nil {
+		rule["redirectOptions"] = redirectOptions
+	}
+	return rule, nil
 }
 
 func expandSecurityPolicyMatch(configured []interface{}) *compute.SecurityPolicyRuleMatcher {
@@ -325,6 +499,7 @@ func expandSecurityPolicyMatch(configured []interface{}) *compute.SecurityPolicy
 	return &compute.SecurityPolicyRuleMatcher{
 		VersionedExpr: data["versioned_expr"].(string),
 		Config:        expandSecurityPolicyMatchConfig(data["config"].([]interface{})),
+		Expr:          expandSecurityPolicyMatchExpr(data["expr"].([]interface{})),
 	}
 }
 
@@ -339,27 +514,189 @@ func expandSecurityPolicyMatchConfig(configured []interface{}) *compute.Security
 	}
 }
 
-func flattenSecurityPolicyRules(rules []*compute.SecurityPolicyRule) []map[string]interface{} {
+func expandSecurityPolicyMatchExpr(configured []interface{}) *compute.Expr {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &compute.Expr{
+		Expression: data["expression"].(string),
+	}
+}
+
+func expandSecurityPolicyRateLimitOptions(configured []interface{}) *securityPolicyRuleRateLimitOptions {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &securityPolicyRuleRateLimitOptions{
+		RateLimitThreshold:    expandSecurityPolicyRateLimitThreshold(data["rate_limit_threshold"].([]interface{})),
+		ConformAction:         data["conform_action"].(string),
+		ExceedAction:          data["exceed_action"].(string),
+		ExceedRedirectOptions: expandSecurityPolicyRedirectOptions(data["exceed_redirect_options"].([]interface{})),
+		EnforceOnKey:          data["enforce_on_key"].(string),
+		EnforceOnKeyName:      data["enforce_on_key_name"].(string),
+		BanThreshold:          expandSecurityPolicyRateLimitThreshold(data["ban_threshold"].([]interface{})),
+		BanDurationSec:        int64(data["ban_duration_sec"].(int)),
+	}
+}
+
+func expandSecurityPolicyRateLimitThreshold(configured []interface{}) *securityPolicyRuleRateLimitOptionsThreshold {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &securityPolicyRuleRateLimitOptionsThreshold{
+		Count:       int64(data["count"].(int)),
+		IntervalSec: int64(data["interval_sec"].(int)),
+	}
+}
+
+func expandSecurityPolicyRedirectOptions(configured []interface{}) *securityPolicyRuleRedirectOptions {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &securityPolicyRuleRedirectOptions{
+		Type:   data["type"].(string),
+		Target: data["target"].(string),
+	}
+}
+
+func flattenSecurityPolicyRules(rules []*compute.SecurityPolicyRule, options []*securityPolicyRuleOptions) []map[string]interface{} {
 	rulesSchema := make([]map[string]interface{}, 0, len(rules))
-	for _, rule := range rules {
+	for i, rule := range rules {
 		data := map[string]interface{}{
 			"description": rule.Description,
 			"priority":    rule.Priority,
 			"action":      rule.Action,
 			"preview":     rule.Preview,
-			"match": []map[string]interface{}{
-				{
-					"versioned_expr": rule.Match.VersionedExpr,
-					"config": []map[string]interface{}{
-						{
-							"src_ip_ranges": schema.NewSet(schema.HashString, convertStringArrToInterface(rule.Match.Config.SrcIpRanges)),
-						},
-					},
-				},
-			},
+			"match":       flattenSecurityPolicyMatch(rule.Match),
+		}
+		if i < len(options) && options[i] != nil {
+			data["rate_limit_options"] = flattenSecurityPolicyRateLimitOptions(options[i].RateLimitOptions)
+			data["redirect_options"] = flattenSecurityPolicyRedirectOptions(options[i].RedirectOptions)
 		}
 
 		rulesSchema = append(rulesSchema, data)
 	}
 	return rulesSchema
 }
+
+func flattenSecurityPolicyMatch(match *compute.SecurityPolicyRuleMatcher) []map[string]interface{} {
+	if match == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"versioned_expr": match.VersionedExpr,
+		"config":         flattenSecurityPolicyMatchConfig(match.Config),
+		"expr":           flattenSecurityPolicyMatchExpr(match.Expr),
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyMatchConfig(conf *compute.SecurityPolicyRuleMatcherConfig) []map[string]interface{} {
+	if conf == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"src_ip_ranges": schema.NewSet(schema.HashString, convertStringArrToInterface(conf.SrcIpRanges)),
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyMatchExpr(expr *compute.Expr) []map[string]interface{} {
+	if expr == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"expression": expr.Expression,
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyRateLimitOptions(conf *securityPolicyRuleRateLimitOptions) []map[string]interface{} {
+	if conf == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"rate_limit_threshold":    flattenSecurityPolicyRateLimitThreshold(conf.RateLimitThreshold),
+		"conform_action":          conf.ConformAction,
+		"exceed_action":           conf.ExceedAction,
+		"exceed_redirect_options": flattenSecurityPolicyRedirectOptions(conf.ExceedRedirectOptions),
+		"enforce_on_key":          conf.EnforceOnKey,
+		"enforce_on_key_name":     conf.EnforceOnKeyName,
+		"ban_threshold":           flattenSecurityPolicyRateLimitThreshold(conf.BanThreshold),
+		"ban_duration_sec":        conf.BanDurationSec,
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyRateLimitThreshold(conf *securityPolicyRuleRateLimitOptionsThreshold) []map[string]interface{} {
+	if conf == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"count":        conf.Count,
+		"interval_sec": conf.IntervalSec,
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyRedirectOptions(conf *securityPolicyRuleRedirectOptions) []map[string]interface{} {
+	if conf == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"type":   conf.Type,
+		"target": conf.Target,
+	}
+	return []map[string]interface{}{data}
+}
+
+// securityPolicyBasePath is used for the requests that carry rule fields the
+// vendored compute client doesn't know about.
+const securityPolicyBasePath = "https://www.googleapis.com/compute/beta/"
+
+// The types below mirror the security policy rule fields that aren't in the
+// vendored compute client yet, so that they can be sent and read as raw JSON.
+
+type securityPolicyRuleOptionsList struct {
+	Rules []*securityPolicyRuleOptions `json:"rules,omitempty"`
+}
+
+type securityPolicyRuleOptions struct {
+	RateLimitOptions *securityPolicyRuleRateLimitOptions `json:"rateLimitOptions,omitempty"`
+	RedirectOptions  *securityPolicyRuleRedirectOptions  `json:"redirectOptions,omitempty"`
+}
+
+type securityPolicyRuleRateLimitOptions struct {
+	RateLimitThreshold    *securityPolicyRuleRateLimitOptionsThreshold `json:"rateLimitThreshold,omitempty"`
+	ConformAction         string                                       `json:"conformAction,omitempty"`
+	ExceedAction          string                                       `json:"exceedAction,omitempty"`
+	ExceedRedirectOptions *securityPolicyRuleRedirectOptions           `json:"exceedRedirectOptions,omitempty"`
+	EnforceOnKey          string                                       `json:"enforceOnKey,omitempty"`
+	EnforceOnKeyName      string                                       `json:"enforceOnKeyName,omitempty"`
+	BanThreshold          *securityPolicyRuleRateLimitOptionsThreshold `json:"banThreshold,omitempty"`
+	BanDurationSec        int64                                        `json:"banDurationSec,omitempty"`
+}
+
+type securityPolicyRuleRateLimitOptionsThreshold struct {
+	Count       int64 `json:"count,omitempty"`
+	IntervalSec int64 `json:"intervalSec,omitempty"`
+}
+
+type securityPolicyRuleRedirectOptions struct {
+	Type   string `json:"type,omitempty"`
+	Target string `json:"target,omitempty"`
+}
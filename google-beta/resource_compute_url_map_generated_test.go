@@ -122,6 +122,7 @@ resource "google_compute_backend_bucket" "static" {
 resource "google_storage_bucket" "static" {
   name     = "static-asset-bucket-%{random_suffix}"
   location = "US"
+  deletion_protection = false
 }
 `, context)
 }
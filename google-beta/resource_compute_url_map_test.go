@@ -2,13 +2,121 @@ package google
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestUrlMapPathRuleCustomizeDiff(t *testing.T) {
+	weightedBackendServices := []interface{}{
+		map[string]interface{}{
+			"weighted_backend_services": []interface{}{
+				map[string]interface{}{
+					"backend_service": "projects/my-project/global/backendServices/foo",
+					"weight":          100,
+				},
+			},
+		},
+	}
+	urlRewrite := []interface{}{
+		map[string]interface{}{
+			"url_rewrite": []interface{}{
+				map[string]interface{}{"path_prefix_rewrite": "/foo"},
+			},
+		},
+	}
+	urlRedirect := []interface{}{
+		map[string]interface{}{"host_redirect": "example.com"},
+	}
+
+	cases := map[string]struct {
+		PathRule      map[string]interface{}
+		ExpectedError string
+	}{
+		"empty path rule": {
+			PathRule:      map[string]interface{}{},
+			ExpectedError: "must set exactly one of",
+		},
+		"service": {
+			PathRule: map[string]interface{}{
+				"service": "projects/my-project/global/backendServices/foo",
+			},
+		},
+		"unknown service": {
+			PathRule: map[string]interface{}{
+				"service": config.UnknownVariableValue,
+			},
+		},
+		"service with url rewrite": {
+			PathRule: map[string]interface{}{
+				"service":      "projects/my-project/global/backendServices/foo",
+				"route_action": urlRewrite,
+			},
+		},
+		"url rewrite without a destination": {
+			PathRule: map[string]interface{}{
+				"route_action": urlRewrite,
+			},
+			ExpectedError: "must set exactly one of",
+		},
+		"weighted backend services": {
+			PathRule: map[string]interface{}{
+				"route_action": weightedBackendServices,
+			},
+		},
+		"service and weighted backend services": {
+			PathRule: map[string]interface{}{
+				"service":      "projects/my-project/global/backendServices/foo",
+				"route_action": weightedBackendServices,
+			},
+			ExpectedError: "must set exactly one of",
+		},
+		"url redirect": {
+			PathRule: map[string]interface{}{
+				"url_redirect": urlRedirect,
+			},
+		},
+		"service and url redirect": {
+			PathRule: map[string]interface{}{
+				"service":      "projects/my-project/global/backendServices/foo",
+				"url_redirect": urlRedirect,
+			},
+			ExpectedError: "can't set service or route_action",
+		},
+	}
+
+	for tn, tc := range cases {
+		tc.PathRule["paths"] = []interface{}{"/*"}
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"name":            "foo",
+			"default_service": "projects/my-project/global/backendServices/foo",
+			"path_matcher": []interface{}{
+				map[string]interface{}{
+					"name":            "bar",
+					"default_service": "projects/my-project/global/backendServices/foo",
+					"path_rule":       []interface{}{tc.PathRule},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", tn, err)
+		}
+
+		_, err = resourceComputeUrlMap().Diff(nil, terraform.NewResourceConfig(raw), nil)
+		if tc.ExpectedError != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Errorf("%s: expected an error containing %q, got %v", tn, tc.ExpectedError, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func TestAccComputeUrlMap_update_path_matcher(t *testing.T) {
 	t.Parallel()
 
@@ -95,6 +203,58 @@ func TestAccComputeUrlMap_noPathRulesWithUpdate(t *testing.T) {
 	})
 }
 
+func TestAccComputeUrlMap_trafficDirectorUpdate(t *testing.T) {
+	t.Parallel()
+
+	randString := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeUrlMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeUrlMap_trafficDirector(randString),
+			},
+			{
+				ResourceName:      "google_compute_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeUrlMap_trafficDirectorUpdate(randString),
+			},
+			{
+				ResourceName:      "google_compute_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeUrlMap_trafficDirectorPathRule(t *testing.T) {
+	t.Parallel()
+
+	randString := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeUrlMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeUrlMap_trafficDirectorPathRule(randString),
+			},
+			{
+				ResourceName:      "google_compute_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeUrlMapExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -363,3 +523,266 @@ resource "google_compute_url_map" "foobar" {
 }
 `, bsName, hcName, umName)
 }
+
+func testAccComputeUrlMap_trafficDirector(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_url_map" "foobar" {
+	name            = "urlmap-test-%s"
+	description     = "a description"
+	default_service = "${google_compute_backend_service.home.self_link}"
+
+	host_rule {
+		hosts        = ["mysite.com"]
+		path_matcher = "allpaths"
+	}
+
+	path_matcher {
+		name            = "allpaths"
+		default_service = "${google_compute_backend_service.home.self_link}"
+
+		route_rules {
+			header_action {
+				request_headers_to_remove = ["RemoveMe2"]
+				request_headers_to_add {
+					header_name  = "AddSomethingElse"
+					header_value = "MyOtherValue"
+					replace      = true
+				}
+				response_headers_to_remove = ["RemoveMe3"]
+				response_headers_to_add {
+					header_name  = "AddMe"
+					header_value = "MyValue"
+					replace      = false
+				}
+			}
+
+			match_rules {
+				full_path_match = "a full path"
+				header_matches {
+					header_name  = "someheader"
+					exact_match  = "match this exactly"
+					invert_match = true
+				}
+				ignore_case = true
+				metadata_filters {
+					filter_match_criteria = "MATCH_ANY"
+					filter_labels {
+						name  = "PLANET"
+						value = "MARS"
+					}
+				}
+				query_parameter_matches {
+					name          = "a query parameter"
+					present_match = true
+				}
+			}
+
+			url_redirect {
+				host_redirect          = "A host"
+				https_redirect         = false
+				path_redirect          = "some/path"
+				redirect_response_code = "TEMPORARY_REDIRECT"
+				strip_query            = true
+			}
+		}
+	}
+
+	test {
+		service = "${google_compute_backend_service.home.self_link}"
+		host    = "hi.com"
+		path    = "/home"
+	}
+}
+%s`, randString, testAccComputeUrlMap_trafficDirectorBackends(randString))
+}
+
+func testAccComputeUrlMap_trafficDirectorUpdate(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_url_map" "foobar" {
+	name            = "urlmap-test-%s"
+	description     = "a description"
+	default_service = "${google_compute_backend_service.home.self_link}"
+
+	host_rule {
+		hosts        = ["mysite.com"]
+		path_matcher = "allpaths"
+	}
+
+	path_matcher {
+		name            = "allpaths"
+		default_service = "${google_compute_backend_service.home.self_link}"
+
+		route_rules {
+			match_rules {
+				prefix_match = "/someprefix"
+				header_matches {
+					header_name = "x-canary"
+					range_match {
+						range_start = 0
+						range_end   = 10
+					}
+				}
+			}
+
+			route_action {
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.home.self_link}"
+					weight          = 90
+				}
+
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.canary.self_link}"
+					weight          = 10
+
+					header_action {
+						request_headers_to_add {
+							header_name  = "x-canary"
+							header_value = "true"
+							replace      = true
+						}
+					}
+				}
+
+				url_rewrite {
+					host_rewrite        = "dev.example.com"
+					path_prefix_rewrite = "/v1/api/"
+				}
+
+				retry_policy {
+					num_retries      = 4
+					retry_conditions = ["5xx", "deadline-exceeded"]
+
+					per_try_timeout {
+						seconds = 30
+					}
+				}
+
+				timeout {
+					seconds = 20
+					nanos   = 750000000
+				}
+
+				fault_injection_policy {
+					abort {
+						http_status = 503
+						percentage  = 10.5
+					}
+
+					delay {
+						percentage = 5.5
+
+						fixed_delay {
+							seconds = 1
+						}
+					}
+				}
+
+				request_mirror_policy {
+					backend_service = "${google_compute_backend_service.canary.self_link}"
+				}
+
+				cors_policy {
+					allow_credentials    = true
+					allow_headers        = ["Allowed content"]
+					allow_methods        = ["GET"]
+					allow_origin_regexes = ["abc.*"]
+					allow_origins        = ["Allowed origin"]
+					expose_headers       = ["Exposed header"]
+					max_age              = 30
+					disabled             = false
+				}
+			}
+		}
+	}
+
+	test {
+		service = "${google_compute_backend_service.home.self_link}"
+		host    = "hi.com"
+		path    = "/home"
+	}
+}
+%s`, randString, testAccComputeUrlMap_trafficDirectorBackends(randString))
+}
+
+func testAccComputeUrlMap_trafficDirectorPathRule(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_url_map" "foobar" {
+	name            = "urlmap-test-%s"
+	default_service = "${google_compute_backend_service.home.self_link}"
+
+	host_rule {
+		hosts        = ["mysite.com"]
+		path_matcher = "allpaths"
+	}
+
+	path_matcher {
+		name            = "allpaths"
+		default_service = "${google_compute_backend_service.home.self_link}"
+
+		path_rule {
+			paths = ["/home"]
+
+			route_action {
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.home.self_link}"
+					weight          = 80
+				}
+
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.canary.self_link}"
+					weight          = 20
+				}
+
+				timeout {
+					seconds = 20
+				}
+			}
+		}
+
+		path_rule {
+			paths = ["/old"]
+
+			url_redirect {
+				path_redirect          = "/home"
+				redirect_response_code = "MOVED_PERMANENTLY_DEFAULT"
+				strip_query            = true
+			}
+		}
+	}
+}
+%s`, randString, testAccComputeUrlMap_trafficDirectorBackends(randString))
+}
+
+func testAccComputeUrlMap_trafficDirectorBackends(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "home" {
+	name                  = "urlmap-test-home-%s"
+	port_name             = "http"
+	protocol              = "HTTP"
+	timeout_sec           = 10
+	load_balancing_scheme = "INTERNAL_SELF_MANAGED"
+
+	health_checks = ["${google_compute_health_check.default.self_link}"]
+}
+
+resource "google_compute_backend_service" "canary" {
+	name                  = "urlmap-test-canary-%s"
+	port_name             = "http"
+	protocol              = "HTTP"
+	timeout_sec           = 10
+	load_balancing_scheme = "INTERNAL_SELF_MANAGED"
+
+	health_checks = ["${google_compute_health_check.default.self_link}"]
+}
+
+resource "google_compute_health_check" "default" {
+	name               = "urlmap-test-%s"
+	check_interval_sec = 1
+	timeout_sec        = 1
+
+	http_health_check {
+		port = 80
+	}
+}
+`, randString, randString, randString)
+}
@@ -942,7 +942,9 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		Cluster: cluster,
 	}
 
-	mutexKV.Lock(containerClusterMutexKey(project, location, clusterName))
+	if err := mutexKV.Lock(containerClusterMutexKey(project, location, clusterName)); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(containerClusterMutexKey(project, location, clusterName))
 
 	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
@@ -1752,7 +1754,9 @@ func resourceContainerClusterDelete(d *schema.ResourceData, meta interface{}) er
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutDelete).Minutes())
 
 	log.Printf("[DEBUG] Deleting GKE cluster %s", d.Get("name").(string))
-	mutexKV.Lock(containerClusterMutexKey(project, location, clusterName))
+	if err := mutexKV.Lock(containerClusterMutexKey(project, location, clusterName)); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(containerClusterMutexKey(project, location, clusterName))
 
 	var op *containerBeta.Operation
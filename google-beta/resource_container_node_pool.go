@@ -225,7 +225,9 @@ func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	mutexKV.Lock(nodePoolInfo.lockKey())
+	if err := mutexKV.Lock(nodePoolInfo.lockKey()); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(nodePoolInfo.lockKey())
 
 	req := &containerBeta.CreateNodePoolRequest{
@@ -351,7 +353,9 @@ func resourceContainerNodePoolDelete(d *schema.ResourceData, meta interface{}) e
 
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutDelete).Minutes())
 
-	mutexKV.Lock(nodePoolInfo.lockKey())
+	if err := mutexKV.Lock(nodePoolInfo.lockKey()); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(nodePoolInfo.lockKey())
 
 	var op = &containerBeta.Operation{}
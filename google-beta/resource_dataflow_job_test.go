@@ -325,6 +325,7 @@ resource "google_storage_bucket" "temp" {
 	name = "dfjob-test-%s-temp"
 
 	force_destroy = true
+	deletion_protection = false
 }
 
 resource "google_dataflow_job" "big_data" {
@@ -349,6 +350,7 @@ resource "google_storage_bucket" "temp" {
 	name = "dfjob-test-%s-temp"
 
 	force_destroy = true
+	deletion_protection = false
 }
 
 resource "google_dataflow_job" "big_data" {
@@ -373,6 +375,7 @@ resource "google_storage_bucket" "temp" {
 	name = "dfjob-test-%s-temp"
 
 	force_destroy = true
+	deletion_protection = false
 }
 
 resource "google_compute_network" "net" {
@@ -402,6 +405,7 @@ resource "google_storage_bucket" "temp" {
 	name = "dfjob-test-%s-temp"
 
 	force_destroy = true
+	deletion_protection = false
 }
 
 resource "google_compute_network" "net" {
@@ -437,6 +441,7 @@ resource "google_storage_bucket" "temp" {
 	name = "dfjob-test-%s-temp"
 
 	force_destroy = true
+	deletion_protection = false
 }
 
 resource "google_service_account" "dataflow-sa" {
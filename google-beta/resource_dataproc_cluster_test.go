@@ -892,6 +892,7 @@ func testAccDataprocCluster_withInitAction(rnd, bucket, objName string) string {
 resource "google_storage_bucket" "init_bucket" {
 	name          = "%s"
 	force_destroy = "true"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "init_script" {
@@ -975,6 +976,7 @@ func testAccDataprocCluster_withStagingBucketOnly(bucketName string) string {
 resource "google_storage_bucket" "bucket" {
 	name          = "%s"
 	force_destroy = "true"
+	deletion_protection = false
 }`, bucketName)
 }
 
@@ -22,17 +22,19 @@ func TestAccFilestoreInstance_update(t *testing.T) {
 				Config: testAccFilestoreInstance_update(name),
 			},
 			{
-				ResourceName:      "google_filestore_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_filestore_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccFilestoreInstance_update2(name),
 			},
 			{
-				ResourceName:      "google_filestore_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_filestore_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -56,6 +58,7 @@ resource "google_filestore_instance" "instance" {
 	}
   tier = "PREMIUM"
 	description = "An instance created during testing."
+  deletion_protection = false
 }
 `, name)
 }
@@ -75,5 +78,6 @@ resource "google_filestore_instance" "instance" {
   }
   tier = "PREMIUM"
 	description = "A modified instance created during testing."
+  deletion_protection = false
 }`, name)
 }
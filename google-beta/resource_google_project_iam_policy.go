@@ -61,7 +61,9 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 	project := d.Get("project").(string)
 
 	mutexKey := getProjectIamPolicyMutexKey(project)
-	mutexKV.Lock(mutexKey)
+	if err := mutexKV.Lock(mutexKey); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(mutexKey)
 
 	// Get the policy in the template
@@ -105,7 +107,9 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 	project := d.Get("project").(string)
 
 	mutexKey := getProjectIamPolicyMutexKey(project)
-	mutexKV.Lock(mutexKey)
+	if err := mutexKV.Lock(mutexKey); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(mutexKey)
 
 	// Get the policy in the template
@@ -129,7 +133,9 @@ func resourceGoogleProjectIamPolicyDelete(d *schema.ResourceData, meta interface
 	project := d.Get("project").(string)
 
 	mutexKey := getProjectIamPolicyMutexKey(project)
-	mutexKV.Lock(mutexKey)
+	if err := mutexKV.Lock(mutexKey); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(mutexKey)
 
 	// Get the existing IAM policy from the API so we can repurpose the etag and audit config
@@ -184,6 +184,7 @@ resource "google_logging_billing_account_sink" "basic" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name     = "%s"
+	deletion_protection = false
 }`, name, billingAccount, getTestProjectFromEnv(), bucketName)
 }
 
@@ -198,6 +199,7 @@ resource "google_logging_billing_account_sink" "update" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name     = "%s"
+	deletion_protection = false
 }`, name, billingAccount, getTestProjectFromEnv(), bucketName)
 }
 
@@ -219,5 +221,6 @@ AND severity>=ERROR
 
 resource "google_storage_bucket" "log-bucket" {
 	name     = "%s"
+	deletion_protection = false
 }`, name, billingAccount, getTestProjectFromEnv(), bucketName)
 }
@@ -231,6 +231,7 @@ resource "google_logging_folder_sink" "basic" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_folder" "my-folder" {
@@ -251,6 +252,7 @@ resource "google_logging_folder_sink" "basic" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_folder" "my-folder" {
@@ -278,6 +280,7 @@ AND severity>=ERROR
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_folder" "my-folder" {
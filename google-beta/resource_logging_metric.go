@@ -233,7 +233,9 @@ func resourceLoggingMetricCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://logging.googleapis.com/v2/projects/{{project}}/metrics")
@@ -364,7 +366,9 @@ func resourceLoggingMetricUpdate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://logging.googleapis.com/v2/projects/{{project}}/metrics/{{%name}}")
@@ -389,7 +393,9 @@ func resourceLoggingMetricDelete(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://logging.googleapis.com/v2/projects/{{project}}/metrics/{{%name}}")
@@ -197,6 +197,7 @@ resource "google_logging_organization_sink" "basic" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }`, sinkName, orgId, getTestProjectFromEnv(), bucketName)
 }
 
@@ -212,6 +213,7 @@ resource "google_logging_organization_sink" "update" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name     = "%s"
+	deletion_protection = false
 }`, sinkName, orgId, getTestProjectFromEnv(), bucketName)
 }
 
@@ -234,5 +236,6 @@ AND severity>=ERROR
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }`, sinkName, orgId, getTestProjectFromEnv(), bucketName)
 }
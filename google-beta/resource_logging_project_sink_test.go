@@ -119,6 +119,7 @@ resource "google_logging_project_sink" "basic" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 `, name, project, project, bucketName)
 }
@@ -135,6 +136,7 @@ resource "google_logging_project_sink" "unique_writer" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 `, name, getTestProjectFromEnv(), bucketName)
 }
@@ -151,6 +153,7 @@ resource "google_logging_project_sink" "unique_writer" {
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 `, name, getTestProjectFromEnv(), bucketName)
 }
@@ -174,6 +177,7 @@ AND severity>=ERROR
 
 resource "google_storage_bucket" "log-bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 `, name, project, project, bucketName)
 }
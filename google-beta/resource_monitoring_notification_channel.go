@@ -131,7 +131,9 @@ func resourceMonitoringNotificationChannelCreate(d *schema.ResourceData, meta in
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v3/projects/{{project}}/notificationChannels")
@@ -259,7 +261,9 @@ func resourceMonitoringNotificationChannelUpdate(d *schema.ResourceData, meta in
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v3/{{name}}")
@@ -284,7 +288,9 @@ func resourceMonitoringNotificationChannelDelete(d *schema.ResourceData, meta in
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v3/{{name}}")
@@ -0,0 +1,190 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oslogin "google.golang.org/api/oslogin/v1"
+)
+
+func resourceOSLoginSSHPublicKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOSLoginSSHPublicKeyCreate,
+		Read:   resourceOSLoginSSHPublicKeyRead,
+		Update: resourceOSLoginSSHPublicKeyUpdate,
+		Delete: resourceOSLoginSSHPublicKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceOSLoginSSHPublicKeyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: optionalSurroundingSpacesSuppress,
+			},
+
+			"expiration_time_usec": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOSLoginSSHPublicKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	user := d.Get("user").(string)
+	key := d.Get("key").(string)
+
+	sshPublicKey := &oslogin.SshPublicKey{
+		Key: key,
+	}
+
+	expiration, err := expandOSLoginSSHPublicKeyExpirationTimeUsec(d.Get("expiration_time_usec"))
+	if err != nil {
+		return err
+	}
+	sshPublicKey.ExpirationTimeUsec = expiration
+
+	call := config.clientOSLogin.Users.ImportSshPublicKey(fmt.Sprintf("users/%s", user), sshPublicKey)
+	if v, ok := d.GetOk("project"); ok {
+		call = call.ProjectId(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing SSH public key for OS Login user %q", user)
+	resp, err := call.Do()
+	if err != nil {
+		return fmt.Errorf("Error importing SSH public key for user %q: %s", user, err)
+	}
+
+	// The import call returns the user's whole login profile, so find the
+	// fingerprint of the key we just added by matching its contents.
+	fingerprint := ""
+	if resp.LoginProfile != nil {
+		for fp, k := range resp.LoginProfile.SshPublicKeys {
+			if strings.TrimSpace(k.Key) == strings.TrimSpace(key) {
+				fingerprint = fp
+				break
+			}
+		}
+	}
+	if fingerprint == "" {
+		return fmt.Errorf("Error importing SSH public key for user %q: key not found in login profile", user)
+	}
+
+	d.SetId(fmt.Sprintf("users/%s/sshPublicKeys/%s", user, fingerprint))
+	d.Set("fingerprint", fingerprint)
+
+	return resourceOSLoginSSHPublicKeyRead(d, meta)
+}
+
+func resourceOSLoginSSHPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	sshPublicKey, err := config.clientOSLogin.Users.SshPublicKeys.Get(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("OS Login SSH public key %q", d.Id()))
+	}
+
+	d.Set("key", sshPublicKey.Key)
+	d.Set("fingerprint", sshPublicKey.Fingerprint)
+	if sshPublicKey.ExpirationTimeUsec != 0 {
+		d.Set("expiration_time_usec", strconv.FormatInt(sshPublicKey.ExpirationTimeUsec, 10))
+	} else {
+		d.Set("expiration_time_usec", "")
+	}
+
+	return nil
+}
+
+func resourceOSLoginSSHPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("expiration_time_usec") {
+		expiration, err := expandOSLoginSSHPublicKeyExpirationTimeUsec(d.Get("expiration_time_usec"))
+		if err != nil {
+			return err
+		}
+
+		sshPublicKey := &oslogin.SshPublicKey{
+			ExpirationTimeUsec: expiration,
+			// Send the zero value explicitly so that removing the
+			// expiration clears it on the key.
+			ForceSendFields: []string{"ExpirationTimeUsec"},
+		}
+
+		log.Printf("[DEBUG] Updating OS Login SSH public key %q", d.Id())
+		_, err = config.clientOSLogin.Users.SshPublicKeys.Patch(d.Id(), sshPublicKey).UpdateMask("expirationTimeUsec").Do()
+		if err != nil {
+			return fmt.Errorf("Error updating OS Login SSH public key %q: %s", d.Id(), err)
+		}
+	}
+
+	return resourceOSLoginSSHPublicKeyRead(d, meta)
+}
+
+func resourceOSLoginSSHPublicKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting OS Login SSH public key %q", d.Id())
+	_, err := config.clientOSLogin.Users.SshPublicKeys.Delete(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("OS Login SSH public key %q", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceOSLoginSSHPublicKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"users/(?P<user>[^/]+)/sshPublicKeys/(?P<fingerprint>[^/]+)", "(?P<user>[^/]+)/(?P<fingerprint>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "users/{{user}}/sshPublicKeys/{{fingerprint}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandOSLoginSSHPublicKeyExpirationTimeUsec(v interface{}) (int64, error) {
+	s := v.(string)
+	if s == "" {
+		return 0, nil
+	}
+
+	expiration, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Error parsing expiration_time_usec %q: %s", s, err)
+	}
+	return expiration, nil
+}
@@ -0,0 +1,115 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOSLoginSSHPublicKey_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOSLoginSSHPublicKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOSLoginSSHPublicKey_basic(),
+			},
+			{
+				ResourceName:      "google_os_login_ssh_public_key.cache",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOSLoginSSHPublicKey_expiration(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOSLoginSSHPublicKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOSLoginSSHPublicKey_expiration("4102444800000000"),
+			},
+			{
+				ResourceName:      "google_os_login_ssh_public_key.cache",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOSLoginSSHPublicKey_expiration("4133980800000000"),
+			},
+			{
+				ResourceName:      "google_os_login_ssh_public_key.cache",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOSLoginSSHPublicKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_os_login_ssh_public_key" {
+			continue
+		}
+
+		_, err := config.clientOSLogin.Users.SshPublicKeys.Get(rs.Primary.ID).Do()
+		if err == nil {
+			return fmt.Errorf("OS Login SSH public key %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccOSLoginSSHPublicKeyProvider = `
+provider "google" {
+  alias = "google-scoped"
+
+  # The OS Login user is looked up from the credentials' email, which needs
+  # the userinfo.email scope in addition to the default scopes.
+  scopes = [
+    "https://www.googleapis.com/auth/compute",
+    "https://www.googleapis.com/auth/cloud-platform",
+    "https://www.googleapis.com/auth/ndev.clouddns.readwrite",
+    "https://www.googleapis.com/auth/devstorage.full_control",
+    "https://www.googleapis.com/auth/userinfo.email",
+  ]
+}
+
+data "google_client_openid_userinfo" "me" {
+  provider = "google.google-scoped"
+}
+`
+
+func testAccOSLoginSSHPublicKey_basic() string {
+	return testAccOSLoginSSHPublicKeyProvider + `
+resource "google_os_login_ssh_public_key" "cache" {
+  provider = "google.google-scoped"
+  user     = "${data.google_client_openid_userinfo.me.email}"
+  key      = "${file("test-fixtures/ssh-rsa.pub")}"
+}
+`
+}
+
+func testAccOSLoginSSHPublicKey_expiration(expiration string) string {
+	return testAccOSLoginSSHPublicKeyProvider + fmt.Sprintf(`
+resource "google_os_login_ssh_public_key" "cache" {
+  provider             = "google.google-scoped"
+  user                 = "${data.google_client_openid_userinfo.me.email}"
+  key                  = "${file("test-fixtures/ssh-rsa.pub")}"
+  expiration_time_usec = "%s"
+}
+`, expiration)
+}
@@ -127,6 +127,11 @@ func resourceRedisInstance() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -375,6 +380,11 @@ func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	if d.HasChange("memory_size_gb") {
 		updateMask = append(updateMask, "memorySizeGb")
 	}
+	// deletion_protection is not sent to the API, so skip the request if it is the only change.
+	if len(updateMask) == 0 {
+		return resourceRedisInstanceRead(d, meta)
+	}
+
 	// updateMask is a URL parameter but not present in the schema, so replaceVars
 	// won't set it
 	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
@@ -406,6 +416,10 @@ func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete Instance %q: deletion_protection is enabled. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", d.Id())
+	}
+
 	url, err := replaceVars(d, config, "https://redis.googleapis.com/v1beta1/projects/{{project}}/locations/{{region}}/instances/{{name}}")
 	if err != nil {
 		return err
@@ -447,6 +461,9 @@ func resourceRedisInstanceImport(d *schema.ResourceData, meta interface{}) ([]*s
 		return nil, fmt.Errorf("Error constructing id: %s", err)
 	}
 	d.SetId(id)
+	// Explicitly set to default as a workaround for `ImportStateVerify` tests, and so that users
+	// don't see a diff immediately after import.
+	d.Set("deletion_protection", true)
 
 	return []*schema.ResourceData{d}, nil
 }
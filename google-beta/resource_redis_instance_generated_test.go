@@ -43,7 +43,7 @@ func TestAccRedisInstance_redisInstanceBasicExample(t *testing.T) {
 				ResourceName:            "google_redis_instance.cache",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region"},
+				ImportStateVerifyIgnore: []string{"region", "deletion_protection"},
 			},
 		},
 	})
@@ -54,6 +54,7 @@ func testAccRedisInstance_redisInstanceBasicExample(context map[string]interface
 resource "google_redis_instance" "cache" {
   name           = "memory-cache-%{random_suffix}"
   memory_size_gb = 1
+  deletion_protection = false
 }
 `, context)
 }
@@ -77,7 +78,7 @@ func TestAccRedisInstance_redisInstanceFullExample(t *testing.T) {
 				ResourceName:            "google_redis_instance.cache",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region"},
+				ImportStateVerifyIgnore: []string{"region", "deletion_protection"},
 			},
 		},
 	})
@@ -103,6 +104,7 @@ resource "google_redis_instance" "cache" {
     my_key    = "my_val"
     other_key = "other_val"
   }
+  deletion_protection = false
 }
 
 resource "google_compute_network" "auto-network" {
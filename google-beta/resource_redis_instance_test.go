@@ -22,17 +22,19 @@ func TestAccRedisInstance_update(t *testing.T) {
 				Config: testAccRedisInstance_update(name),
 			},
 			{
-				ResourceName:      "google_redis_instance.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_redis_instance.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccRedisInstance_update2(name),
 			},
 			{
-				ResourceName:      "google_redis_instance.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_redis_instance.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -55,6 +57,7 @@ resource "google_redis_instance" "test" {
 		maxmemory-policy       = "allkeys-lru"
 		notify-keyspace-events = "KEA"
 	}
+	deletion_protection = false
 }`, name)
 }
 
@@ -74,5 +77,6 @@ resource "google_redis_instance" "test" {
 		maxmemory-policy       = "noeviction"
 		notify-keyspace-events = ""
 	}
+	deletion_protection = false
 }`, name)
 }
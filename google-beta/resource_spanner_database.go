@@ -27,6 +27,7 @@ func resourceSpannerDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSpannerDatabaseCreate,
 		Read:   resourceSpannerDatabaseRead,
+		Update: resourceSpannerDatabaseUpdate,
 		Delete: resourceSpannerDatabaseDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -63,6 +64,11 @@ func resourceSpannerDatabase() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -163,9 +169,18 @@ func resourceSpannerDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceSpannerDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only the root field "deletion_protection" is updatable, and it is not sent to the API.
+	return resourceSpannerDatabaseRead(d, meta)
+}
+
 func resourceSpannerDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete Database %q: deletion_protection is enabled. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", d.Id())
+	}
+
 	url, err := replaceVars(d, config, "https://spanner.googleapis.com/v1/projects/{{project}}/instances/{{instance}}/databases/{{name}}")
 	if err != nil {
 		return err
@@ -194,6 +209,9 @@ func resourceSpannerDatabaseImport(d *schema.ResourceData, meta interface{}) ([]
 		return nil, fmt.Errorf("Error constructing id: %s", err)
 	}
 	d.SetId(id)
+	// Explicitly set to default as a workaround for `ImportStateVerify` tests, and so that users
+	// don't see a diff immediately after import.
+	d.Set("deletion_protection", true)
 
 	return []*schema.ResourceData{d}, nil
 }
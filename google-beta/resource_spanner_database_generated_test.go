@@ -43,7 +43,7 @@ func TestAccSpannerDatabase_spannerDatabaseBasicExample(t *testing.T) {
 				ResourceName:            "google_spanner_database.database",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ddl"},
+				ImportStateVerifyIgnore: []string{"ddl", "deletion_protection"},
 			},
 		},
 	})
@@ -63,6 +63,7 @@ resource "google_spanner_database" "database" {
     "CREATE TABLE t1 (t1 INT64 NOT NULL,) PRIMARY KEY(t1)",
     "CREATE TABLE t2 (t2 INT64 NOT NULL,) PRIMARY KEY(t2)"
   ]
+  deletion_protection = false
 }
 `, context)
 }
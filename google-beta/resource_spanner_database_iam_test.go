@@ -131,6 +131,7 @@ resource "google_spanner_instance" "instance" {
 resource "google_spanner_database" "database" {
   instance = "${google_spanner_instance.instance.name}"
   name     = "%s"
+  deletion_protection = false
 }
 
 resource "google_spanner_database_iam_binding" "foo" {
@@ -165,6 +166,7 @@ resource "google_spanner_instance" "instance" {
 resource "google_spanner_database" "database" {
   instance = "${google_spanner_instance.instance.name}"
   name     = "%s"
+  deletion_protection = false
 }
 
 resource "google_spanner_database_iam_binding" "foo" {
@@ -197,6 +199,7 @@ resource "google_spanner_instance" "instance" {
 resource "google_spanner_database" "database" {
   instance = "${google_spanner_instance.instance.name}"
   name     = "%s"
+  deletion_protection = false
 }
 
 resource "google_spanner_database_iam_member" "foo" {
@@ -226,6 +229,7 @@ resource "google_spanner_instance" "instance" {
 resource "google_spanner_database" "database" {
   instance = "${google_spanner_instance.instance.name}"
   name     = "%s"
+  deletion_protection = false
 }
 
 data "google_iam_policy" "foo" {
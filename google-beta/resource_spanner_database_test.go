@@ -29,27 +29,31 @@ func TestAccSpannerDatabase_basic(t *testing.T) {
 			},
 			{
 				// Test import with default Terraform ID
-				ResourceName:      "google_spanner_database.basic",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_spanner_database.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      "google_spanner_database.basic",
-				ImportStateId:     fmt.Sprintf("projects/%s/instances/%s/databases/%s", project, instanceName, databaseName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_spanner_database.basic",
+				ImportStateId:           fmt.Sprintf("projects/%s/instances/%s/databases/%s", project, instanceName, databaseName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      "google_spanner_database.basic",
-				ImportStateId:     fmt.Sprintf("instances/%s/databases/%s", instanceName, databaseName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_spanner_database.basic",
+				ImportStateId:           fmt.Sprintf("instances/%s/databases/%s", instanceName, databaseName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      "google_spanner_database.basic",
-				ImportStateId:     fmt.Sprintf("%s/%s", instanceName, databaseName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_spanner_database.basic",
+				ImportStateId:           fmt.Sprintf("%s/%s", instanceName, databaseName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -67,6 +71,7 @@ resource "google_spanner_instance" "basic" {
 resource "google_spanner_database" "basic" {
   instance      = "${google_spanner_instance.basic.name}"
   name          = "%s"
+  deletion_protection = false
 }
 `, instanceName, instanceName, databaseName)
 }
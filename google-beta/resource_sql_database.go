@@ -109,7 +109,9 @@ func resourceSqlDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/sql/v1beta4/projects/{{project}}/instances/{{instance}}/databases")
@@ -228,7 +230,9 @@ func resourceSqlDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/sql/v1beta4/projects/{{project}}/instances/{{instance}}/databases/{{name}}")
@@ -271,7 +275,9 @@ func resourceSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	mutexKV.Lock(lockName)
+	if err := mutexKV.Lock(lockName); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockName)
 
 	url, err := replaceVars(d, config, "https://www.googleapis.com/sql/v1beta4/projects/{{project}}/instances/{{instance}}/databases/{{name}}")
@@ -314,6 +314,12 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -486,7 +492,9 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	// modified at the same time. Lock the master until we're done in order
 	// to prevent that.
 	if !sqlDatabaseIsMaster(d) {
-		mutexKV.Lock(instanceMutexKey(project, instance.MasterInstanceName))
+		if err := mutexKV.Lock(instanceMutexKey(project, instance.MasterInstanceName)); err != nil {
+			return err
+		}
 		defer mutexKV.Unlock(instanceMutexKey(project, instance.MasterInstanceName))
 	}
 
@@ -525,7 +533,7 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		for _, u := range users.Items {
 			if u.Name == "root" && u.Host == "%" {
 				err = retry(func() error {
-					op, err = config.clientSqlAdmin.Users.Delete(project, instance.Name, u.Host, u.Name).Do()
+					op, err = config.clientSqlAdmin.Users.Delete(project, instance.Name).Host(u.Host).Name(u.Name).Do()
 					if err == nil {
 						err = sqlAdminOperationWaitTime(config.clientSqlAdmin, op, project, "Delete default root User", int(d.Timeout(schema.TimeoutCreate).Minutes()))
 					}
@@ -769,6 +777,12 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// deletion_protection is only stored in state, so there is nothing to
+	// send to the API unless the settings changed.
+	if !d.HasChange("settings") {
+		return resourceSqlDatabaseInstanceRead(d, meta)
+	}
+
 	// Update only updates the settings, so they are all we need to set.
 	instance := &sqladmin.DatabaseInstance{
 		Settings: expandSqlDatabaseInstanceSettings(d.Get("settings").([]interface{}), !isFirstGen(d)),
@@ -777,7 +791,9 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 	// Lock on the master_instance_name just in case updating any replica
 	// settings causes operations on the master.
 	if v, ok := d.GetOk("master_instance_name"); ok {
-		mutexKV.Lock(instanceMutexKey(project, v.(string)))
+		if err := mutexKV.Lock(instanceMutexKey(project, v.(string))); err != nil {
+			return err
+		}
 		defer mutexKV.Unlock(instanceMutexKey(project, v.(string)))
 	}
 
@@ -797,6 +813,10 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 func resourceSqlDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete SQL database instance %s: deletion_protection is enabled. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", d.Id())
+	}
+
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
@@ -805,7 +825,9 @@ func resourceSqlDatabaseInstanceDelete(d *schema.ResourceData, meta interface{})
 	// Lock on the master_instance_name just in case deleting a replica causes
 	// operations on the master.
 	if v, ok := d.GetOk("master_instance_name"); ok {
-		mutexKV.Lock(instanceMutexKey(project, v.(string)))
+		if err := mutexKV.Lock(instanceMutexKey(project, v.(string))); err != nil {
+			return err
+		}
 		defer mutexKV.Unlock(instanceMutexKey(project, v.(string)))
 	}
 
@@ -843,6 +865,9 @@ func resourceSqlDatabaseInstanceImport(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(id)
 
+	// deletion_protection isn't returned by the API, so default it on import.
+	d.Set("deletion_protection", true)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 // Fields that should be ignored in import tests because they aren't returned
 // from GCP (and thus can't be imported)
 var ignoredReplicaConfigurationFields = []string{
+	"deletion_protection",
 	"replica_configuration.0.ca_certificate",
 	"replica_configuration.0.client_certificate",
 	"replica_configuration.0.client_key",
@@ -161,21 +163,24 @@ func TestAccSqlDatabaseInstance_basicFirstGen(t *testing.T) {
 				Config: fmt.Sprintf(testGoogleSqlDatabaseInstance_basic, instanceID),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      resourceName,
-				ImportStateId:     fmt.Sprintf("projects/%s/instances/%s", getTestProjectFromEnv(), instanceName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportStateId:           fmt.Sprintf("projects/%s/instances/%s", getTestProjectFromEnv(), instanceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      resourceName,
-				ImportStateId:     fmt.Sprintf("%s/%s", getTestProjectFromEnv(), instanceName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportStateId:           fmt.Sprintf("%s/%s", getTestProjectFromEnv(), instanceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -193,9 +198,36 @@ func TestAccSqlDatabaseInstance_basicInferredName(t *testing.T) {
 				Config: testGoogleSqlDatabaseInstance_basic2,
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccSqlDatabaseInstance_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	databaseName := "tf-test-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testGoogleSqlDatabaseInstance_deletionProtection, databaseName, true),
+			},
+			{
+				Config:      fmt.Sprintf(testGoogleSqlDatabaseInstance_deletionProtection, databaseName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection is enabled"),
+			},
+			// Disable deletion_protection, otherwise the test harness can't delete the instance
+			{
+				Config: fmt.Sprintf(testGoogleSqlDatabaseInstance_deletionProtection, databaseName, false),
 			},
 		},
 	})
@@ -217,9 +249,10 @@ func TestAccSqlDatabaseInstance_basicSecondGen(t *testing.T) {
 				Check: testAccCheckGoogleSqlDatabaseRootUserDoesNotExist(databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -243,9 +276,10 @@ func TestAccSqlDatabaseInstance_dontDeleteDefaultUserOnReplica(t *testing.T) {
 				Config: testGoogleSqlDatabaseInstanceConfig_withoutReplica(databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				PreConfig: func() {
@@ -288,9 +322,10 @@ func TestAccSqlDatabaseInstance_settings_basic(t *testing.T) {
 					testGoogleSqlDatabaseInstance_settings, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -311,9 +346,10 @@ func TestAccSqlDatabaseInstance_replica(t *testing.T) {
 					testGoogleSqlDatabaseInstance_replica, databaseID, databaseID, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance_master",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance_master",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				ResourceName:            "google_sql_database_instance.replica1",
@@ -347,14 +383,16 @@ func TestAccSqlDatabaseInstance_slave(t *testing.T) {
 					testGoogleSqlDatabaseInstance_slave, masterID, slaveID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance_master",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance_master",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance_slave",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance_slave",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -375,9 +413,10 @@ func TestAccSqlDatabaseInstance_highAvailability(t *testing.T) {
 					testGoogleSqlDatabaseInstance_highAvailability, instanceID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -398,9 +437,10 @@ func TestAccSqlDatabaseInstance_diskspecs(t *testing.T) {
 					testGoogleSqlDatabaseInstance_diskspecs, masterID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -421,9 +461,10 @@ func TestAccSqlDatabaseInstance_maintenance(t *testing.T) {
 					testGoogleSqlDatabaseInstance_maintenance, masterID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -444,18 +485,20 @@ func TestAccSqlDatabaseInstance_settings_upgrade(t *testing.T) {
 					testGoogleSqlDatabaseInstance_basic, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_settings, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -476,18 +519,20 @@ func TestAccSqlDatabaseInstance_settingsDowngrade(t *testing.T) {
 					testGoogleSqlDatabaseInstance_settings, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_basic, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -509,27 +554,30 @@ func TestAccSqlDatabaseInstance_authNets(t *testing.T) {
 					testGoogleSqlDatabaseInstance_authNets_step1, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_authNets_step2, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_authNets_step1, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -552,9 +600,10 @@ func TestAccSqlDatabaseInstance_multipleOperations(t *testing.T) {
 					testGoogleSqlDatabaseInstance_multipleOperations, databaseID, instanceID, userID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -576,18 +625,20 @@ func TestAccSqlDatabaseInstance_basic_with_user_labels(t *testing.T) {
 				Check: testAccCheckGoogleSqlDatabaseRootUserDoesNotExist(databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_basic_with_user_labels_update, databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -609,9 +660,10 @@ func TestAccSqlDatabaseInstance_withPrivateNetwork(t *testing.T) {
 				Config: testAccSqlDatabaseInstance_withPrivateNetwork(databaseName, networkName, addressName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -662,6 +714,7 @@ resource "google_sql_database_instance" "instance" {
 		tier = "D0"
 		crash_safe_replication = false
 	}
+	deletion_protection = false
 }
 `
 
@@ -672,8 +725,20 @@ resource "google_sql_database_instance" "instance" {
 		tier = "D0"
 		crash_safe_replication = false
 	}
+	deletion_protection = false
 }
 `
+var testGoogleSqlDatabaseInstance_deletionProtection = `
+resource "google_sql_database_instance" "instance" {
+	name = "%s"
+	region = "us-central1"
+	settings {
+		tier = "db-f1-micro"
+	}
+	deletion_protection = %t
+}
+`
+
 var testGoogleSqlDatabaseInstance_basic3 = `
 resource "google_sql_database_instance" "instance" {
 	name = "%s"
@@ -681,6 +746,7 @@ resource "google_sql_database_instance" "instance" {
 	settings {
 		tier = "db-f1-micro"
 	}
+	deletion_protection = false
 }
 `
 
@@ -718,6 +784,7 @@ resource "google_sql_database_instance" "instance" {
         start_time         = "18:00"
     }
   }
+  deletion_protection = false
 }
 
 resource "google_sql_database_instance" "instance-failover" {
@@ -733,6 +800,7 @@ resource "google_sql_database_instance" "instance-failover" {
   settings {
     tier             = "db-n1-standard-1"
   }
+  deletion_protection = false
 }
 `, instanceName, failoverName)
 }
@@ -769,6 +837,7 @@ resource "google_sql_database_instance" "instance" {
 			private_network = "${google_compute_network.foobar.self_link}"
 		}
 	}
+	deletion_protection = false
 }
 `, networkName, addressRangeName, databaseName)
 }
@@ -801,6 +870,7 @@ resource "google_sql_database_instance" "instance" {
 
 		activation_policy = "ON_DEMAND"
 	}
+	deletion_protection = false
 }
 `
 
@@ -819,6 +889,7 @@ resource "google_sql_database_instance" "instance_master" {
 			binary_log_enabled = true
 		}
 	}
+	deletion_protection = false
 }
 
 resource "google_sql_database_instance" "replica1" {
@@ -840,6 +911,7 @@ resource "google_sql_database_instance" "replica1" {
 		ssl_cipher = "ALL"
 		verify_server_certificate = false
 	}
+	deletion_protection = false
 }
 
 resource "google_sql_database_instance" "replica2" {
@@ -861,6 +933,7 @@ resource "google_sql_database_instance" "replica2" {
 		ssl_cipher = "ALL"
 		verify_server_certificate = false
 	}
+	deletion_protection = false
 }
 `
 
@@ -877,6 +950,7 @@ resource "google_sql_database_instance" "instance_master" {
 			binary_log_enabled = true
 		}
 	}
+	deletion_protection = false
 }
 
 resource "google_sql_database_instance" "instance_slave" {
@@ -888,6 +962,7 @@ resource "google_sql_database_instance" "instance_slave" {
 	settings {
 		tier = "db-f1-micro"
 	}
+	deletion_protection = false
 }
 `
 
@@ -906,6 +981,7 @@ resource "google_sql_database_instance" "instance" {
 			enabled = true
 		}
 	}
+	deletion_protection = false
 }
 `
 
@@ -920,6 +996,7 @@ resource "google_sql_database_instance" "instance" {
 		disk_size = 15
 		disk_type = "PD_HDD"
 	}
+	deletion_protection = false
 }
 `
 
@@ -937,6 +1014,7 @@ resource "google_sql_database_instance" "instance" {
 			update_track = "canary"
 	  }
 	}
+	deletion_protection = false
 }
 `
 
@@ -957,6 +1035,7 @@ resource "google_sql_database_instance" "instance" {
 			}
 		}
 	}
+	deletion_protection = false
 }
 `
 
@@ -972,6 +1051,7 @@ resource "google_sql_database_instance" "instance" {
 			ipv4_enabled = "true"
 		}
 	}
+	deletion_protection = false
 }
 `
 
@@ -983,6 +1063,7 @@ resource "google_sql_database_instance" "instance" {
 		tier = "D0"
 		crash_safe_replication = false
 	}
+	deletion_protection = false
 }
 
 resource "google_sql_database" "database" {
@@ -1009,6 +1090,7 @@ resource "google_sql_database_instance" "instance" {
 		    location = "western-division"
 		}
 	}
+	deletion_protection = false
 }
 `
 var testGoogleSqlDatabaseInstance_basic_with_user_labels_update = `
@@ -1021,5 +1103,6 @@ resource "google_sql_database_instance" "instance" {
 		    track = "production"
 		}
 	}
+	deletion_protection = false
 }
 `
@@ -187,6 +187,7 @@ resource "google_sql_database_instance" "instance" {
 	settings {
 		tier = "D0"
 	}
+	deletion_protection = false
 }
 
 resource "google_sql_database" "database" {
@@ -201,6 +202,7 @@ resource "google_sql_database_instance" "instance" {
 	settings {
 		tier = "D0"
 	}
+	deletion_protection = false
 }
 
 resource "google_sql_database" "database" {
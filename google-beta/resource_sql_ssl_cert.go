@@ -90,7 +90,9 @@ func resourceSqlSslCertCreate(d *schema.ResourceData, meta interface{}) error {
 		CommonName: commonName,
 	}
 
-	mutexKV.Lock(instanceMutexKey(project, instance))
+	if err := mutexKV.Lock(instanceMutexKey(project, instance)); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(instanceMutexKey(project, instance))
 	resp, err := config.clientSqlAdmin.SslCerts.Insert(project, instance, sslCertsInsertRequest).Do()
 	if err != nil {
@@ -164,7 +166,9 @@ func resourceSqlSslCertDelete(d *schema.ResourceData, meta interface{}) error {
 	commonName := d.Get("common_name").(string)
 	fingerprint := d.Get("sha1_fingerprint").(string)
 
-	mutexKV.Lock(instanceMutexKey(project, instance))
+	if err := mutexKV.Lock(instanceMutexKey(project, instance)); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(instanceMutexKey(project, instance))
 	op, err := config.clientSqlAdmin.SslCerts.Delete(project, instance, fingerprint).Do()
 
@@ -102,6 +102,7 @@ func testGoogleSqlClientCert_mysql(instance string) string {
 		settings {
 			tier = "D0"
 		}
+		deletion_protection = false
 	}
 
 	resource "google_sql_ssl_cert" "cert1" {
@@ -131,6 +132,7 @@ func testGoogleSqlClientCert_postgres(instance string) string {
 			create = "20m"
 			delete = "20m"
 		}
+		deletion_protection = false
 	}
 
 	resource "google_sql_ssl_cert" "cert" {
@@ -77,7 +77,9 @@ func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 		Host:     host,
 	}
 
-	mutexKV.Lock(instanceMutexKey(project, instance))
+	if err := mutexKV.Lock(instanceMutexKey(project, instance)); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(instanceMutexKey(project, instance))
 	op, err := config.clientSqlAdmin.Users.Insert(project, instance,
 		user).Do()
@@ -168,10 +170,12 @@ func resourceSqlUserUpdate(d *schema.ResourceData, meta interface{}) error {
 			Password: password,
 		}
 
-		mutexKV.Lock(instanceMutexKey(project, instance))
+		if err := mutexKV.Lock(instanceMutexKey(project, instance)); err != nil {
+			return err
+		}
 		defer mutexKV.Unlock(instanceMutexKey(project, instance))
-		op, err := config.clientSqlAdmin.Users.Update(project, instance, name,
-			user).Host(host).Do()
+		op, err := config.clientSqlAdmin.Users.Update(project, instance,
+			user).Host(host).Name(name).Do()
 
 		if err != nil {
 			return fmt.Errorf("Error, failed to update"+
@@ -203,12 +207,14 @@ func resourceSqlUserDelete(d *schema.ResourceData, meta interface{}) error {
 	instance := d.Get("instance").(string)
 	host := d.Get("host").(string)
 
-	mutexKV.Lock(instanceMutexKey(project, instance))
+	if err := mutexKV.Lock(instanceMutexKey(project, instance)); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(instanceMutexKey(project, instance))
 
 	var op *sqladmin.Operation
 	err = retryTimeDuration(func() error {
-		op, err = config.clientSqlAdmin.Users.Delete(project, instance, host, name).Do()
+		op, err = config.clientSqlAdmin.Users.Delete(project, instance).Host(host).Name(name).Do()
 		return err
 	}, d.Timeout(schema.TimeoutDelete))
 
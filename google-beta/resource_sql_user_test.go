@@ -138,6 +138,7 @@ func testGoogleSqlUser_mysql(instance, password string) string {
 		settings {
 			tier = "D0"
 		}
+		deletion_protection = false
 	}
 
 	resource "google_sql_user" "user1" {
@@ -166,6 +167,7 @@ func testGoogleSqlUser_postgres(instance, password string) string {
 		settings {
 			tier = "db-f1-micro"
 		}
+		deletion_protection = false
 	}
 
 	resource "google_sql_user" "user" {
@@ -62,6 +62,12 @@ func resourceStorageBucket() *schema.Resource {
 				Default:  false,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -542,6 +548,10 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 	// Get the bucket
 	bucket := d.Get("name").(string)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete bucket %s: deletion_protection is enabled. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", bucket)
+	}
+
 	for {
 		res, err := config.clientStorage.Objects.List(bucket).Versions(true).Do()
 		if err != nil {
@@ -631,6 +641,7 @@ func resourceStorageBucketStateImporter(d *schema.ResourceData, meta interface{}
 	}
 
 	d.Set("force_destroy", false)
+	d.Set("deletion_protection", true)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -214,6 +214,7 @@ func testGoogleStorageBucketsAclBasic1(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_acl" "acl" {
@@ -227,6 +228,7 @@ func testGoogleStorageBucketsAclBasic2(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_acl" "acl" {
@@ -240,6 +242,7 @@ func testGoogleStorageBucketsAclBasicDelete(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_acl" "acl" {
@@ -253,6 +256,7 @@ func testGoogleStorageBucketsAclBasic3(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_acl" "acl" {
@@ -266,6 +270,7 @@ func testGoogleStorageBucketsAclUnordered(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_acl" "acl" {
@@ -279,6 +284,7 @@ func testGoogleStorageBucketsAclPredefined(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_acl" "acl" {
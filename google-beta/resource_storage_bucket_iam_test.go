@@ -120,6 +120,7 @@ func testAccStorageBucketIamPolicy_update(bucket, account, serviceAcct string) s
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_service_account" "test-account-1" {
@@ -164,6 +165,7 @@ func testAccStorageBucketIamPolicy_basic(bucket, account, serviceAcct string) st
 
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_service_account" "test-account-1" {
@@ -201,6 +203,7 @@ func testAccStorageBucketIamBinding_basic(bucket, account string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_service_account" "test-account-1" {
@@ -222,6 +225,7 @@ func testAccStorageBucketIamBinding_update(bucket, account string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_service_account" "test-account-1" {
@@ -249,6 +253,7 @@ func testAccStorageBucketIamMember_basic(bucket, account string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_service_account" "test-account-1" {
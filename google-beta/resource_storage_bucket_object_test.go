@@ -303,6 +303,7 @@ func testGoogleStorageBucketsObjectContent(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -317,6 +318,7 @@ func testGoogleStorageBucketsObjectDynamicContent(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -331,6 +333,7 @@ func testGoogleStorageBucketsObjectBasic(bucketName, sourceFilename string) stri
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -346,6 +349,7 @@ func testGoogleStorageBucketsObject_optionalContentFields(
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -364,6 +368,7 @@ func testGoogleStorageBucketsObject_cacheControl(bucketName, sourceFilename, cac
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -379,6 +384,7 @@ func testGoogleStorageBucketsObject_storageClass(bucketName string, storageClass
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
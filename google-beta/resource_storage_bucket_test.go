@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -32,15 +33,50 @@ func TestAccStorageBucket_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportStateId:     fmt.Sprintf("%s/%s", getTestProjectFromEnv(), bucketName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportStateId:           fmt.Sprintf("%s/%s", getTestProjectFromEnv(), bucketName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_deletionProtectionDefault(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccStorageBucket_deletionProtectionDefault(bucketName),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection"),
+			},
+			{
+				Config: testAccStorageBucket_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "deletion_protection", "false"),
+				),
 			},
 		},
 	})
@@ -64,9 +100,10 @@ func TestAccStorageBucket_requesterPays(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -86,9 +123,10 @@ func TestAccStorageBucket_lowercaseLocation(t *testing.T) {
 				Config: testAccStorageBucket_lowercaseLocation(bucketName),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -115,7 +153,7 @@ func TestAccStorageBucket_customAttributes(t *testing.T) {
 				ResourceName:            "google_storage_bucket.bucket",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"deletion_protection", "force_destroy"},
 			},
 		},
 	})
@@ -134,9 +172,10 @@ func TestAccStorageBucket_lifecycleRulesMultiple(t *testing.T) {
 				Config: testAccStorageBucket_lifecycleRulesMultiple(bucketName),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -173,9 +212,10 @@ func TestAccStorageBucket_lifecycleRuleStateLive(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_lifecycleRule_withStateLive(bucketName),
@@ -190,9 +230,10 @@ func TestAccStorageBucket_lifecycleRuleStateLive(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -229,9 +270,10 @@ func TestAccStorageBucket_lifecycleRuleStateArchived(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_lifecycleRule_isLiveFalse(bucketName),
@@ -246,9 +288,10 @@ func TestAccStorageBucket_lifecycleRuleStateArchived(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_lifecycleRule_withStateArchived(bucketName),
@@ -263,9 +306,10 @@ func TestAccStorageBucket_lifecycleRuleStateArchived(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -312,9 +356,10 @@ func TestAccStorageBucket_lifecycleRuleStateAny(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_lifecycleRule_withStateLive(bucketName),
@@ -327,9 +372,10 @@ func TestAccStorageBucket_lifecycleRuleStateAny(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_lifecycleRule_withStateAny(bucketName),
@@ -342,9 +388,10 @@ func TestAccStorageBucket_lifecycleRuleStateAny(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_lifecycleRule_withStateArchived(bucketName),
@@ -357,9 +404,10 @@ func TestAccStorageBucket_lifecycleRuleStateAny(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -385,9 +433,10 @@ func TestAccStorageBucket_storageClass(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_storageClass(bucketName, "NEARLINE", ""),
@@ -399,9 +448,10 @@ func TestAccStorageBucket_storageClass(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_storageClass(bucketName, "REGIONAL", "US-CENTRAL1"),
@@ -413,9 +463,10 @@ func TestAccStorageBucket_storageClass(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -441,9 +492,10 @@ func TestAccStorageBucket_update_requesterPays(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_requesterPays(bucketName, false),
@@ -454,9 +506,10 @@ func TestAccStorageBucket_update_requesterPays(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -488,7 +541,7 @@ func TestAccStorageBucket_update(t *testing.T) {
 				ResourceName:            "google_storage_bucket.bucket",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"deletion_protection", "force_destroy"},
 			},
 			{
 				Config: testAccStorageBucket_customAttributes(bucketName),
@@ -504,7 +557,7 @@ func TestAccStorageBucket_update(t *testing.T) {
 				ResourceName:            "google_storage_bucket.bucket",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"deletion_protection", "force_destroy"},
 			},
 			{
 				Config: testAccStorageBucket_customAttributes_withLifecycle1(bucketName),
@@ -520,7 +573,7 @@ func TestAccStorageBucket_update(t *testing.T) {
 				ResourceName:            "google_storage_bucket.bucket",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"deletion_protection", "force_destroy"},
 			},
 			{
 				Config: testAccStorageBucket_customAttributes_withLifecycle2(bucketName),
@@ -536,7 +589,7 @@ func TestAccStorageBucket_update(t *testing.T) {
 				ResourceName:            "google_storage_bucket.bucket",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"deletion_protection", "force_destroy"},
 			},
 			{
 				Config: testAccStorageBucket_customAttributes(bucketName),
@@ -552,7 +605,7 @@ func TestAccStorageBucket_update(t *testing.T) {
 				ResourceName:            "google_storage_bucket.bucket",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"deletion_protection", "force_destroy"},
 			},
 		},
 	})
@@ -649,9 +702,10 @@ func TestAccStorageBucket_versioning(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -678,9 +732,10 @@ func TestAccStorageBucket_logging(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_loggingWithPrefix(bucketName, "another-log-bucket", "object-prefix"),
@@ -694,9 +749,10 @@ func TestAccStorageBucket_logging(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_basic(bucketName),
@@ -706,9 +762,10 @@ func TestAccStorageBucket_logging(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -728,9 +785,10 @@ func TestAccStorageBucket_cors(t *testing.T) {
 				Config: testGoogleStorageBucketsCors(bucketName),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -754,9 +812,10 @@ func TestAccStorageBucket_encryption(t *testing.T) {
 				Config: testAccStorageBucket_encryption(context),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -775,17 +834,19 @@ func TestAccStorageBucket_bucketPolicyOnly(t *testing.T) {
 				Config: testAccStorageBucket_bucketPolicyOnly(bucketName, false),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: testAccStorageBucket_bucketPolicyOnly(bucketName, true),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -806,27 +867,30 @@ func TestAccStorageBucket_labels(t *testing.T) {
 				Config: testAccStorageBucket_updateLabels(bucketName),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			// Down to only one label (test single label deletion)
 			{
 				Config: testAccStorageBucket_labels(bucketName),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			// And make sure deleting all labels work
 			{
 				Config: testAccStorageBucket_basic(bucketName),
 			},
 			{
-				ResourceName:      "google_storage_bucket.bucket",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -955,6 +1019,15 @@ func testAccStorageBucketDestroy(s *terraform.State) error {
 
 func testAccStorageBucket_basic(bucketName string) string {
 	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	deletion_protection = false
+}
+`, bucketName)
+}
+
+func testAccStorageBucket_deletionProtectionDefault(bucketName string) string {
+	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
 }
@@ -966,6 +1039,7 @@ func testAccStorageBucket_requesterPays(bucketName string, pays bool) string {
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
 	requester_pays = %t
+	deletion_protection = false
 }
 `, bucketName, pays)
 }
@@ -975,6 +1049,7 @@ func testAccStorageBucket_lowercaseLocation(bucketName string) string {
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
 	location = "eu"
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -985,6 +1060,7 @@ resource "google_storage_bucket" "bucket" {
 	name = "%s"
 	location = "EU"
 	force_destroy = "true"
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1003,6 +1079,8 @@ resource "google_storage_bucket" "bucket" {
 			age = 10
 		}
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1031,6 +1109,8 @@ resource "google_storage_bucket" "bucket" {
 			num_newer_versions = 2
 		}
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1045,6 +1125,7 @@ func testAccStorageBucket_storageClass(bucketName, storageClass, location string
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
 	storage_class = "%s"%s
+	deletion_protection = false
 }
 `, bucketName, storageClass, locationBlock)
 }
@@ -1066,6 +1147,8 @@ resource "google_storage_bucket" "bucket" {
 	  response_header = ["000"]
 	  max_age_seconds = 5
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1078,6 +1161,8 @@ resource "google_storage_bucket" "bucket" {
 	versioning {
 	  enabled = "true"
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1089,6 +1174,8 @@ resource "google_storage_bucket" "bucket" {
 	versioning {
 	  enabled = "true"
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1100,6 +1187,8 @@ resource "google_storage_bucket" "bucket" {
 	logging {
 		log_bucket = "%s"
 	}
+
+	deletion_protection = false
 }
 `, bucketName, logBucketName)
 }
@@ -1112,6 +1201,8 @@ resource "google_storage_bucket" "bucket" {
 		log_bucket = "%s"
 		log_object_prefix = "%s"
 	}
+
+	deletion_protection = false
 }
 `, bucketName, logBucketName, prefix)
 }
@@ -1156,6 +1247,8 @@ resource "google_storage_bucket" "bucket" {
 			num_newer_versions = 10
 		}
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1173,6 +1266,8 @@ resource "google_storage_bucket" "bucket" {
       age = 10
     }
   }
+
+  deletion_protection = false
 }
 `, bucketName)
 }
@@ -1191,6 +1286,8 @@ resource "google_storage_bucket" "bucket" {
       is_live = false
     }
   }
+
+  deletion_protection = false
 }
 `, bucketName)
 }
@@ -1209,6 +1306,8 @@ resource "google_storage_bucket" "bucket" {
       with_state = "ARCHIVED"
     }
   }
+
+  deletion_protection = false
 }
 `, bucketName)
 }
@@ -1227,6 +1326,8 @@ resource "google_storage_bucket" "bucket" {
       is_live = true
     }
   }
+
+  deletion_protection = false
 }
 `, bucketName)
 }
@@ -1245,6 +1346,8 @@ resource "google_storage_bucket" "bucket" {
       with_state = "LIVE"
     }
   }
+
+  deletion_protection = false
 }
 `, bucketName)
 }
@@ -1263,6 +1366,8 @@ resource "google_storage_bucket" "bucket" {
       with_state = "ANY"
     }
   }
+
+  deletion_protection = false
 }
 `, bucketName)
 }
@@ -1274,6 +1379,8 @@ resource "google_storage_bucket" "bucket" {
 	labels = {
 		my-label = "my-label-value"
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
@@ -1283,6 +1390,7 @@ func testAccStorageBucket_bucketPolicyOnly(bucketName string, enabled bool) stri
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
 	bucket_policy_only = %t
+	deletion_protection = false
 }
 `, bucketName, enabled)
 }
@@ -1321,6 +1429,8 @@ resource "google_storage_bucket" "bucket" {
 	encryption {
 		default_kms_key_name = "${google_kms_crypto_key.crypto_key.self_link}"
 	}
+
+	deletion_protection = false
 }
 	`, context)
 }
@@ -1333,6 +1443,8 @@ resource "google_storage_bucket" "bucket" {
 		my-label    = "my-updated-label-value"
 		a-new-label = "a-new-label-value"
 	}
+
+	deletion_protection = false
 }
 `, bucketName)
 }
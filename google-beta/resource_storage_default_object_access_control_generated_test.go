@@ -59,6 +59,7 @@ resource "google_storage_default_object_access_control" "public_rule" {
 
 resource "google_storage_bucket" "bucket" {
 	name = "static-content-bucket-%{random_suffix}"
+	deletion_protection = false
 }
 `, context)
 }
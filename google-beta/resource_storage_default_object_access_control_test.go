@@ -45,6 +45,7 @@ func testGoogleStorageDefaultObjectAccessControlBasic(bucketName, role, entity s
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_default_object_access_control" "default" {
@@ -197,6 +197,7 @@ func testGoogleStorageDefaultObjectsAclBasic(bucketName, roleEntity1, roleEntity
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_default_object_acl" "acl" {
@@ -210,6 +211,7 @@ func testGoogleStorageDefaultObjectsAclBasicDelete(bucketName, roleEntity string
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_default_object_acl" "acl" {
@@ -223,6 +225,7 @@ func testGoogleStorageDefaultObjectsAclNoRoleEntity(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_default_object_acl" "acl" {
@@ -236,6 +239,7 @@ func testGoogleStorageDefaultObjectAclUnordered(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
   name = "%s"
+  deletion_protection = false
 }
 
 resource "google_storage_default_object_acl" "acl" {
@@ -179,6 +179,7 @@ func testGoogleStorageNotificationBasic(bucketName, topicName, topic string) str
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 		
 resource "google_pubsub_topic" "topic" {
@@ -219,6 +220,7 @@ func testGoogleStorageNotificationOptionalEventsAttributes(bucketName, topicName
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 		
 resource "google_pubsub_topic" "topic" {
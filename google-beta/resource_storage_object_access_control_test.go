@@ -51,6 +51,7 @@ func testGoogleStorageObjectAccessControlBasic(bucketName, objectName, role, ent
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -342,6 +342,7 @@ func testGoogleStorageObjectsAclBasicDelete(bucketName string, objectName string
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -362,6 +363,7 @@ func testGoogleStorageObjectsAclBasic1(bucketName string, objectName string) str
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -383,6 +385,7 @@ func testGoogleStorageObjectsAclBasic2(bucketName string, objectName string) str
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -404,6 +407,7 @@ func testGoogleStorageObjectsAclBasic3(bucketName string, objectName string) str
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -425,6 +429,7 @@ func testGoogleStorageObjectsAclPredefined(bucketName string, objectName string)
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -445,6 +450,7 @@ func testGoogleStorageObjectAclUnordered(bucketName, objectName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
 	name = "%s"
+	deletion_protection = false
 }
 
 resource "google_storage_bucket_object" "object" {
@@ -70,6 +70,7 @@ resource "google_storage_bucket" "data_source" {
   name          = "%s"
   project       = "%s"
   force_destroy = true
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_iam_member" "data_source" {
@@ -82,6 +83,7 @@ resource "google_storage_bucket" "data_sink" {
   name          = "%s"
   project       = "%s"
   force_destroy = true
+  deletion_protection = false
 }
 
 resource "google_storage_bucket_iam_member" "data_sink" {
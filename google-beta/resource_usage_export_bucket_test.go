@@ -48,6 +48,7 @@ resource "google_project_service" "service" {
 resource "google_storage_bucket" "bucket" {
   name = "b-${google_project.base.project_id}"
 	project = "${google_project_service.service.project}"
+  deletion_protection = false
 }
 
 resource "google_project_usage_export_bucket" "ueb" {
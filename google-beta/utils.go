@@ -405,7 +405,9 @@ func extractFirstMapConfig(m []interface{}) map[string]interface{} {
 }
 
 func lockedCall(lockKey string, f func() error) error {
-	mutexKV.Lock(lockKey)
+	if err := mutexKV.Lock(lockKey); err != nil {
+		return err
+	}
 	defer mutexKV.Unlock(lockKey)
 
 	return f()
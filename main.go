@@ -8,4 +8,7 @@ import (
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: google.Provider})
+
+	// Serve returns once Terraform shuts the provider down at the end of the run.
+	google.LogMutexKVStats()
 }